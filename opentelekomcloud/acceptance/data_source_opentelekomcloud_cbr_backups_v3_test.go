@@ -0,0 +1,38 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccCBRBackupsV3DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_cbr_backups_v3.backups"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCBRCheckpointV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRBackupsV3DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.0.status", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "backups.0.resource_id",
+						"opentelekomcloud_blockstorage_volume_v2.volume", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testCBRBackupsV3DataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_cbr_backups_v3" "backups" {
+  checkpoint_id = opentelekomcloud_cbr_checkpoint_v3.checkpoint.id
+  resource_id   = opentelekomcloud_blockstorage_volume_v2.volume.id
+}
+`, testCBRCheckpointV3_basic)
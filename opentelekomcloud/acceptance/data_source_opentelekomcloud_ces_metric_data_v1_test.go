@@ -0,0 +1,51 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccCESMetricDataV1DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_ces_metric_data_v1.data"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCESMetricDataV1DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "datapoints.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "average"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCESMetricDataV1DataSourceBasic() string {
+	now := time.Now().UTC()
+	return fmt.Sprintf(`
+data "opentelekomcloud_ces_metrics_v1" "metrics" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+}
+
+data "opentelekomcloud_ces_metric_data_v1" "data" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+  from        = "%s"
+  to          = "%s"
+  period      = 300
+  filter      = "max"
+
+  dimensions {
+    name  = data.opentelekomcloud_ces_metrics_v1.metrics.metrics.0.dimensions.0.name
+    value = data.opentelekomcloud_ces_metrics_v1.metrics.metrics.0.dimensions.0.value
+  }
+}
+`, now.Add(-24*time.Hour).Format(time.RFC3339), now.Format(time.RFC3339))
+}
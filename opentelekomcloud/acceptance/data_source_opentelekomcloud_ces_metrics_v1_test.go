@@ -0,0 +1,33 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccCESMetricsV1DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_ces_metrics_v1.metrics"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCESMetricsV1DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "metrics.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.namespace", "SYS.ECS"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.metric_name", "cpu_util"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCESMetricsV1DataSourceBasic = `
+data "opentelekomcloud_ces_metrics_v1" "metrics" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+}
+`
@@ -0,0 +1,35 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLBV2CertificateDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_lb_certificate_v2.certificate_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2CertificateDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "opentelekomcloud_lb_certificate_v2.certificate_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain", "www.elb.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "server"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2CertificateDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name = opentelekomcloud_lb_certificate_v2.certificate_1.name
+}
+`, testAccLBV2CertificateConfig_basic)
@@ -0,0 +1,50 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLBV2ListenerDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_lb_listener_v2.listener_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2ListenerDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "opentelekomcloud_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "protocol_port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2ListenerDataSourceBasic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_ds"
+  vip_subnet_id = "%s"
+
+  tags = {
+    muh = "kuh"
+  }
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_ds"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+data "opentelekomcloud_lb_listener_v2" "listener_1" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  protocol_port   = opentelekomcloud_lb_listener_v2.listener_1.protocol_port
+}
+`, OS_SUBNET_ID)
@@ -0,0 +1,51 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2LoadBalancerDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vip_address", "opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "vip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.muh", "kuh"),
+					resource.TestCheckResourceAttrPair("data.opentelekomcloud_lb_loadbalancer_v2.by_vip", "id", "opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2LoadBalancerDataSourceBasic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_ds"
+  vip_subnet_id = "%s"
+
+  tags = {
+    muh = "kuh"
+  }
+}
+
+data "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.name
+
+  tags = {
+    muh = "kuh"
+  }
+}
+
+data "opentelekomcloud_lb_loadbalancer_v2" "by_vip" {
+  vip_address = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.vip_address
+}
+`, OS_SUBNET_ID)
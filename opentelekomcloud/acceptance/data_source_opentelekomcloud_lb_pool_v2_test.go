@@ -0,0 +1,56 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLBV2PoolDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_lb_pool_v2.pool_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "opentelekomcloud_lb_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "listener_id", "opentelekomcloud_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lb_method", "ROUND_ROBIN"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2PoolDataSourceBasic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_ds"
+  vip_subnet_id = "%s"
+
+  tags = {
+    muh = "kuh"
+  }
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_ds"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_ds"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+data "opentelekomcloud_lb_pool_v2" "pool_1" {
+  listener_id = opentelekomcloud_lb_pool_v2.pool_1.listener_id
+}
+`, OS_SUBNET_ID)
@@ -0,0 +1,41 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNatGatewayV2DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_nat_gateway_v2.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatGatewayV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "opentelekomcloud_nat_gateway_v2.nat_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_nat_gateway_v2.by_id", "name", "opentelekomcloud_nat_gateway_v2.nat_1", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNatGatewayV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_nat_gateway_v2" "by_name" {
+  name = opentelekomcloud_nat_gateway_v2.nat_1.name
+}
+
+data "opentelekomcloud_nat_gateway_v2" "by_id" {
+  id = opentelekomcloud_nat_gateway_v2.nat_1.id
+}
+`, testAccNatV2Gateway_basic)
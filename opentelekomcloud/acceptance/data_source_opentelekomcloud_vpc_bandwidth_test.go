@@ -36,9 +36,9 @@ func testAccCheckBandWidthDataSourceExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("root module has no resource called %s", n)
 		}
 
-		bandwidthRs, ok := s.RootModule().Resources["opentelekomcloud_vpc_bandwidth.test"]
+		bandwidthRs, ok := s.RootModule().Resources["opentelekomcloud_vpc_bandwidth_v2.test"]
 		if !ok {
-			return fmt.Errorf("can't find opentelekomcloud_vpc_bandwidth.test in state")
+			return fmt.Errorf("can't find opentelekomcloud_vpc_bandwidth_v2.test in state")
 		}
 
 		attr := rs.Primary.Attributes
@@ -53,13 +53,13 @@ func testAccCheckBandWidthDataSourceExists(n string) resource.TestCheckFunc {
 
 func testAccBandWidthDataSource_basic(rName string) string {
 	return fmt.Sprintf(`
-resource "opentelekomcloud_vpc_bandwidth" "test" {
-	name = "%s"
-	size = 10
+resource "opentelekomcloud_vpc_bandwidth_v2" "test" {
+  name = "%s"
+  size = 10
 }
 
 data "opentelekomcloud_vpc_bandwidth" "test" {
-  name = opentelekomcloud_vpc_bandwidth.test.name
+  name = opentelekomcloud_vpc_bandwidth_v2.test.name
 }
 `, rName)
 }
@@ -20,7 +20,8 @@ func TestAccOTCVpcSubnetIdsV2DataSource_basic(t *testing.T) {
 				Config: testAccOTCSubnetIdV2DataSource_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccOTCSubnetIdV2DataSourceID("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids"),
-					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids_tagged", "ids.#", "1"),
 				),
 			},
 		},
@@ -53,6 +54,17 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   gateway_ip = "192.168.0.1"
   vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
 }
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2" {
+  name = "opentelekomcloud_subnet_tagged"
+  cidr = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+  vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
+
+  tags = {
+    muh = "kuh"
+  }
+}
 `
 
 var testAccOTCSubnetIdV2DataSource_basic = fmt.Sprintf(`
@@ -60,4 +72,12 @@ var testAccOTCSubnetIdV2DataSource_basic = fmt.Sprintf(`
 data "opentelekomcloud_vpc_subnet_ids_v1" "subnet_ids" {
   vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
 }
+
+data "opentelekomcloud_vpc_subnet_ids_v1" "subnet_ids_tagged" {
+  vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
+
+  tags = {
+    muh = "kuh"
+  }
+}
 `, testAccOTCSubnetIdV2DataSource_vpcsubnet)
@@ -0,0 +1,28 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNatSnatRule_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_nat_snat_rule_v2.snat_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatV2SnatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatV2SnatRule_basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -40,6 +40,8 @@ var (
 	OS_BMS_FLAVOR_NAME        = os.Getenv("OS_BMS_FLAVOR_NAME")
 	OS_NIC_ID                 = os.Getenv("OS_NIC_ID")
 	OS_TO_TENANT_ID           = os.Getenv("OS_TO_TENANT_ID")
+	OS_DESTINATION_REGION     = os.Getenv("OS_DESTINATION_REGION")
+	OS_DESTINATION_PROJECT_ID = os.Getenv("OS_DESTINATION_PROJECT_ID")
 	OS_TENANT_NAME            = getTenantName()
 )
 
@@ -148,6 +150,14 @@ func testAccPreCheckBMSNic(t *testing.T) {
 	}
 }
 
+func testAccPreCheckReplication(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_DESTINATION_REGION == "" || OS_DESTINATION_PROJECT_ID == "" {
+		t.Skip("OS_DESTINATION_REGION and OS_DESTINATION_PROJECT_ID must be set for replication acceptance tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_TENANT_ADMIN")
 	if v == "" {
@@ -38,6 +38,9 @@ func TestAccASV1Group_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckASV1GroupExists(resourceName, &asGroup),
 					resource.TestCheckResourceAttr(resourceName, "health_periodic_audit_grace_period", "500"),
+					resource.TestCheckResourceAttr(resourceName, "cool_down_time", "600"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_groups.0.id",
+						"opentelekomcloud_networking_secgroup_v2.secgroup_2", "id"),
 					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-update"),
 				),
 			},
@@ -188,6 +191,10 @@ resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
   name = "test-acc"
 }
 
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_2" {
+  name = "test-acc-2"
+}
+
 resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
   name          = "loadbalancer_1"
   vip_subnet_id = "%s"
@@ -227,7 +234,7 @@ resource "opentelekomcloud_as_group_v1" "hth_as_group"{
     id = "%s"
   }
   security_groups {
-    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+    id = opentelekomcloud_networking_secgroup_v2.secgroup_2.id
   }
   lbaas_listeners {
     pool_id =       opentelekomcloud_lb_pool_v2.pool_1.id
@@ -235,6 +242,7 @@ resource "opentelekomcloud_as_group_v1" "hth_as_group"{
   }
   vpc_id = "%s"
 
+  cool_down_time                     = 600
   health_periodic_audit_grace_period = 500
 
   tags = {
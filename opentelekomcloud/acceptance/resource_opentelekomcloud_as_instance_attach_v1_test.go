@@ -0,0 +1,147 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccASV1InstanceAttach_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_as_instance_attach_v1.attach"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccFlavorPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1InstanceAttachDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASV1InstanceAttach_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1InstanceAttachExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "INSERVICE"),
+					resource.TestCheckResourceAttr(resourceName, "protected", "false"),
+				),
+			},
+			{
+				Config: testASV1InstanceAttach_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1InstanceAttachExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protected", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccASV1InstanceAttachInGroup(rs *terraform.ResourceState) (bool, error) {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.AutoscalingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return false, fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	page, err := instances.List(client, rs.Primary.Attributes["scaling_group_id"], nil).AllPages()
+	if err != nil {
+		return false, err
+	}
+	instanceList, err := page.(instances.InstancePage).Extract()
+	if err != nil {
+		return false, err
+	}
+	for _, instance := range instanceList {
+		if instance.ID == rs.Primary.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccCheckASV1InstanceAttachDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_as_instance_attach_v1" {
+			continue
+		}
+
+		found, err := testAccASV1InstanceAttachInGroup(rs)
+		if err == nil && found {
+			return fmt.Errorf("instance %s is still attached to AS group", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASV1InstanceAttachExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		found, err := testAccASV1InstanceAttachInGroup(rs)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("instance %s is not attached to AS group", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testASV1InstanceAttach_basic(protected bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "test-acc"
+}
+
+resource "opentelekomcloud_as_configuration_v1" "as_config"{
+  scaling_configuration_name = "as_config"
+  instance_config {
+    image = "%[1]s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    key_name = "%[2]s"
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "as_group"{
+  scaling_group_name       = "as_group"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.as_config.id
+  max_instance_number      = 3
+  networks {
+    id = "%[3]s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  vpc_id = "%[4]s"
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance" {
+  name              = "as_attached_instance"
+  image_id          = "%[1]s"
+  key_pair          = "%[2]s"
+  availability_zone = "%[5]s"
+  security_groups   = [opentelekomcloud_networking_secgroup_v2.secgroup.name]
+
+  network {
+    uuid = "%[3]s"
+  }
+}
+
+resource "opentelekomcloud_as_instance_attach_v1" "attach" {
+  scaling_group_id = opentelekomcloud_as_group_v1.as_group.id
+  instance_id      = opentelekomcloud_compute_instance_v2.instance.id
+  protected        = %[6]t
+}
+`, OS_IMAGE_ID, OS_KEYPAIR_NAME, OS_NETWORK_ID, OS_VPC_ID, OS_AVAILABILITY_ZONE, protected)
+}
@@ -0,0 +1,166 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/as"
+)
+
+func TestAccASV1LifecycleHook_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_as_lifecycle_hook_v1.hook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccFlavorPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1LifecycleHookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASV1LifecycleHook_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1LifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "ADD"),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "ABANDON"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "3600"),
+				),
+			},
+			{
+				Config: testASV1LifecycleHook_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1LifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "REMOVE"),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "CONTINUE"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "600"),
+					resource.TestCheckResourceAttr(resourceName, "notification_message", "scaling in"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccASV1LifecycleHookImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccASV1LifecycleHookImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckASV1LifecycleHookDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.AutoscalingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_as_lifecycle_hook_v1" {
+			continue
+		}
+
+		_, err := as.GetLifecycleHook(client, rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("AS lifecycle hook still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASV1LifecycleHookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.AutoscalingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+		}
+
+		_, err = as.GetLifecycleHook(client, rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error retrieving AS lifecycle hook: %s", err)
+		}
+
+		return nil
+	}
+}
+
+var testASV1LifecycleHook_group = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "test-acc"
+}
+
+resource "opentelekomcloud_as_configuration_v1" "as_config"{
+  scaling_configuration_name = "as_config"
+  instance_config {
+    image = "%s"
+    disk {
+      size        = 40
+      volume_type = "SATA"
+      disk_type   = "SYS"
+    }
+    key_name = "%s"
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "as_group"{
+  scaling_group_name       = "as_group"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.as_config.id
+  networks {
+    id = "%s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  vpc_id = "%s"
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "as_hook_topic"
+}
+`, OS_IMAGE_ID, OS_KEYPAIR_NAME, OS_NETWORK_ID, OS_VPC_ID)
+
+var testASV1LifecycleHook_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_as_lifecycle_hook_v1" "hook" {
+  name                   = "as_hook"
+  scaling_group_id       = opentelekomcloud_as_group_v1.as_group.id
+  type                   = "ADD"
+  notification_topic_urn = opentelekomcloud_smn_topic_v2.topic.topic_urn
+}
+`, testASV1LifecycleHook_group)
+
+var testASV1LifecycleHook_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_as_lifecycle_hook_v1" "hook" {
+  name                   = "as_hook"
+  scaling_group_id       = opentelekomcloud_as_group_v1.as_group.id
+  type                   = "REMOVE"
+  default_result         = "CONTINUE"
+  timeout                = 600
+  notification_topic_urn = opentelekomcloud_smn_topic_v2.topic.topic_urn
+  notification_message   = "scaling in"
+}
+`, testASV1LifecycleHook_group)
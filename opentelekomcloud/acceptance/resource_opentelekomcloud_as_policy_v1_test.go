@@ -31,6 +31,37 @@ func TestAccASV1Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccASV1Policy_targetTracking(t *testing.T) {
+	var asPolicy policies.Policy
+	resourceName := "opentelekomcloud_as_policy_v1.hth_as_policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccFlavorPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASV1PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASV1Policy_targetTracking(70),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1PolicyExists(resourceName, &asPolicy),
+					resource.TestCheckResourceAttr(resourceName, "scaling_policy_type", "TARGET_TRACKING"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking.0.target_value", "70"),
+					resource.TestCheckResourceAttrSet(resourceName, "alarm_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_in_alarm_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_in_policy_id"),
+				),
+			},
+			{
+				Config: testASV1Policy_targetTracking(60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASV1PolicyExists(resourceName, &asPolicy),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking.0.target_value", "60"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckASV1PolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	asClient, err := config.AutoscalingV1Client(OS_REGION_NAME)
@@ -47,6 +78,11 @@ func testAccCheckASV1PolicyDestroy(s *terraform.State) error {
 		if err == nil {
 			return fmt.Errorf("AS policy still exists")
 		}
+		if scaleInID := rs.Primary.Attributes["scale_in_policy_id"]; scaleInID != "" {
+			if _, err := policies.Get(asClient, scaleInID).Extract(); err == nil {
+				return fmt.Errorf("AS scale-in policy still exists")
+			}
+		}
 	}
 
 	log.Printf("[DEBUG] testCheckASV1PolicyDestroy success!")
@@ -132,3 +168,49 @@ resource "opentelekomcloud_as_policy_v1" "hth_as_policy"{
   }
 }
 `, OS_IMAGE_ID, OS_NETWORK_ID, OS_VPC_ID)
+
+func testASV1Policy_targetTracking(targetValue int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name        = "terraform"
+  description = "This is a terraform test security group"
+}
+
+resource "opentelekomcloud_as_configuration_v1" "hth_as_config"{
+  scaling_configuration_name = "hth_as_config"
+  instance_config {
+    image = "%s"
+    disk {
+      size = 40
+      volume_type = "SATA"
+      disk_type = "SYS"
+    }
+    key_name = "%s"
+  }
+}
+
+resource "opentelekomcloud_as_group_v1" "hth_as_group"{
+  scaling_group_name = "hth_as_group"
+  scaling_configuration_id = opentelekomcloud_as_configuration_v1.hth_as_config.id
+  max_instance_number = 3
+  networks {
+    id = "%s"
+  }
+  security_groups {
+    id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+  }
+  vpc_id = "%s"
+}
+
+resource "opentelekomcloud_as_policy_v1" "hth_as_policy"{
+  scaling_policy_name = "terraform"
+  scaling_group_id    = opentelekomcloud_as_group_v1.hth_as_group.id
+  scaling_policy_type = "TARGET_TRACKING"
+
+  target_tracking {
+    metric_name  = "cpu_util"
+    target_value = %d
+  }
+}
+`, OS_IMAGE_ID, OS_KEYPAIR_NAME, OS_NETWORK_ID, OS_VPC_ID, targetValue)
+}
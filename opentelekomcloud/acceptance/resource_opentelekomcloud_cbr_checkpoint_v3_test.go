@@ -0,0 +1,123 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/cbr"
+)
+
+func TestAccCBRCheckpointV3_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_cbr_checkpoint_v3.checkpoint"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCBRCheckpointV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRCheckpointV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCBRCheckpointV3Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "backups.0.resource_id",
+						"opentelekomcloud_blockstorage_volume_v2.volume", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCBRCheckpointV3Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.CbrV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cbr_checkpoint_v3" {
+			continue
+		}
+
+		backups, err := cbr.ListBackups(client, cbr.BackupListOpts{CheckpointID: rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if len(backups) != 0 {
+			return fmt.Errorf("CBRv3 checkpoint backups still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCBRCheckpointV3Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.CbrV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+		}
+
+		checkpoint, err := cbr.GetCheckpoint(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if checkpoint.ID != rs.Primary.ID {
+			return fmt.Errorf("CBRv3 checkpoint not found")
+		}
+
+		return nil
+	}
+}
+
+const testCBRCheckpointV3_vault = `
+resource "opentelekomcloud_blockstorage_volume_v2" "volume" {
+  name = "cbr-test-volume"
+  size = 10
+
+  volume_type = "SSD"
+}
+
+resource "opentelekomcloud_cbr_vault_v3" "vault" {
+  name = "cbr-vault-test"
+
+  description = "CBR vault for terraform provider test"
+
+  billing {
+    size          = 100
+    object_type   = "disk"
+    protect_type  = "backup"
+    charging_mode = "post_paid"
+  }
+
+  resource {
+    id   = opentelekomcloud_blockstorage_volume_v2.volume.id
+    type = "OS::Cinder::Volume"
+  }
+}
+`
+
+var testCBRCheckpointV3_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cbr_checkpoint_v3" "checkpoint" {
+  vault_id    = opentelekomcloud_cbr_vault_v3.vault.id
+  name        = "cbr-checkpoint-test"
+  description = "CBR checkpoint for terraform provider test"
+}
+`, testCBRCheckpointV3_vault)
@@ -40,6 +40,28 @@ func TestAccCBRPolicyV3_basic(t *testing.T) {
 	})
 }
 
+func TestAccCBRPolicyV3_replication(t *testing.T) {
+	var cbrPolicy policies.Policy
+	policyRes := "opentelekomcloud_cbr_policy_v3.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckReplication(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCBRPolicyV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRPolicyV3_replication,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCBRPolicyV3Exists(policyRes, &cbrPolicy),
+					resource.TestCheckResourceAttr(policyRes, "operation_type", "replication"),
+					resource.TestCheckResourceAttr(policyRes, "operation_definition.0.destination_region", OS_DESTINATION_REGION),
+					resource.TestCheckResourceAttr(policyRes, "operation_definition.0.destination_project_id", OS_DESTINATION_PROJECT_ID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCBRPolicyV3_minConfig(t *testing.T) {
 	var cbrPolicy policies.Policy
 	policyRes := "opentelekomcloud_cbr_policy_v3.policy"
@@ -173,3 +195,20 @@ resource opentelekomcloud_cbr_policy_v3 policy {
 }
 `
 )
+
+var testCBRPolicyV3_replication = fmt.Sprintf(`
+resource "opentelekomcloud_cbr_policy_v3" "policy" {
+  name           = "test-replication-policy"
+  operation_type = "replication"
+
+  trigger_pattern = [
+    "FREQ=DAILY;BYHOUR=14;BYMINUTE=00"
+  ]
+  operation_definition {
+    max_backups            = 10
+    timezone               = "UTC+03:00"
+    destination_region     = "%s"
+    destination_project_id = "%s"
+  }
+}
+`, OS_DESTINATION_REGION, OS_DESTINATION_PROJECT_ID)
@@ -0,0 +1,34 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccCBRRestoreV3_volume(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCBRCheckpointV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCBRRestoreV3_volume,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("opentelekomcloud_cbr_restore_v3.restore", "backup_id",
+						"opentelekomcloud_cbr_checkpoint_v3.checkpoint", "backups.0.id"),
+				),
+			},
+		},
+	})
+}
+
+var testCBRRestoreV3_volume = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cbr_restore_v3" "restore" {
+  backup_id = opentelekomcloud_cbr_checkpoint_v3.checkpoint.backups.0.id
+  volume_id = opentelekomcloud_blockstorage_volume_v2.volume.id
+}
+`, testCBRCheckpointV3_basic)
@@ -0,0 +1,287 @@
+package acceptance
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cloudeyeservice/alarmrule"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ces"
+)
+
+func TestAccCESAlarmTemplateV1_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_ces_alarm_template_v1.template_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCESAlarmTemplateV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCESAlarmTemplateV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESAlarmTemplateV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccCESAlarmTemplateV1Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESAlarmTemplateV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_ids.#", "4"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_group_id"},
+			},
+		},
+	})
+}
+
+func TestAccCESAlarmTemplateV1_groupMembership(t *testing.T) {
+	resourceName := "opentelekomcloud_ces_alarm_template_v1.template_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCESAlarmTemplateV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCESAlarmTemplateV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESAlarmTemplateV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_ids.#", "2"),
+				),
+			},
+			{
+				// the group is changed after the template is planned, so the alarms follow on the next run
+				Config: testAccCESAlarmTemplateV1SingleResource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_ces_resource_group_v1.group_1", "resources.#", "1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCESAlarmTemplateV1SingleResource,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESAlarmTemplateV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_targets.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCESAlarmTemplateV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.CesV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CES client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ces_alarm_template_v1" {
+			continue
+		}
+
+		if _, err := ces.GetAlarmTemplate(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("CES alarm template still exists")
+		}
+		alarmCount, _ := strconv.Atoi(rs.Primary.Attributes["alarm_ids.#"])
+		for i := 0; i < alarmCount; i++ {
+			alarmID := rs.Primary.Attributes[fmt.Sprintf("alarm_ids.%d", i)]
+			if _, err := alarmrule.Get(client, alarmID).Extract(); err == nil {
+				return fmt.Errorf("alarm rule %s of CES alarm template still exists", alarmID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCESAlarmTemplateV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.CesV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud CES client: %s", err)
+		}
+
+		found, err := ces.GetAlarmTemplate(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.TemplateID != rs.Primary.ID {
+			return fmt.Errorf("CES alarm template not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccCESAlarmTemplateV1Group = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm" {
+  count = 2
+  name  = "instance_${count.index}"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_ces_resource_group_v1" "group_1" {
+  name = "group_1"
+
+  dynamic "resources" {
+    for_each = opentelekomcloud_compute_instance_v2.vm[*].id
+    content {
+      namespace = "SYS.ECS"
+      dimensions {
+        name  = "instance_id"
+        value = resources.value
+      }
+    }
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccCESAlarmTemplateV1Basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_alarm_template_v1" "template_1" {
+  name              = "template_1"
+  resource_group_id = opentelekomcloud_ces_resource_group_v1.group_1.id
+
+  policy {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%%"
+    count               = 3
+  }
+
+  alarm_action_enabled = false
+  alarm_actions {
+    type              = "notification"
+    notification_list = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+  }
+}
+`, testAccCESAlarmTemplateV1Group)
+
+var testAccCESAlarmTemplateV1Update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_alarm_template_v1" "template_1" {
+  name              = "template_1"
+  description       = "updated"
+  resource_group_id = opentelekomcloud_ces_resource_group_v1.group_1.id
+
+  policy {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 90
+    unit                = "%%"
+    count               = 3
+  }
+
+  policy {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "network_outgoing_bytes_rate_inband"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 6
+    unit                = "B/s"
+    count               = 1
+    alarm_level         = 3
+  }
+
+  alarm_action_enabled = false
+  alarm_actions {
+    type              = "notification"
+    notification_list = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+  }
+}
+`, testAccCESAlarmTemplateV1Group)
+
+var testAccCESAlarmTemplateV1SingleResource = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm" {
+  count = 2
+  name  = "instance_${count.index}"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_ces_resource_group_v1" "group_1" {
+  name = "group_1"
+
+  resources {
+    namespace = "SYS.ECS"
+    dimensions {
+      name  = "instance_id"
+      value = opentelekomcloud_compute_instance_v2.vm[0].id
+    }
+  }
+}
+
+resource "opentelekomcloud_ces_alarm_template_v1" "template_1" {
+  name              = "template_1"
+  resource_group_id = opentelekomcloud_ces_resource_group_v1.group_1.id
+
+  policy {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%%"
+    count               = 3
+  }
+
+  alarm_action_enabled = false
+  alarm_actions {
+    type              = "notification"
+    notification_list = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+  }
+}
+`, OS_NETWORK_ID)
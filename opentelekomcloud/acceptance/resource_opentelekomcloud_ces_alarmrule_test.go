@@ -13,6 +13,7 @@ import (
 
 func TestCESAlarmRule_basic(t *testing.T) {
 	var ar alarmrule.AlarmRule
+	var alarmID string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -23,15 +24,36 @@ func TestCESAlarmRule_basic(t *testing.T) {
 				Config: testCESAlarmRule_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testCESAlarmRuleExists("opentelekomcloud_ces_alarmrule.alarmrule_1", &ar),
+					func(s *terraform.State) error {
+						alarmID = s.RootModule().Resources["opentelekomcloud_ces_alarmrule.alarmrule_1"].Primary.ID
+						return nil
+					},
 				),
 			},
 			{
 				Config: testCESAlarmRule_update,
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "id", &alarmID),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_level", "3"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "condition.0.value", "10"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "condition.0.count", "2"),
 				),
 			},
+			{
+				ResourceName:      "opentelekomcloud_ces_alarmrule.alarmrule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"update_time",
+					"alarm_state",
+				},
+			},
 		},
 	})
 }
@@ -144,7 +166,8 @@ resource "opentelekomcloud_smn_topic_v2" "topic_1" {
 }
 
 resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
-  alarm_name = "alarm_rule1"
+  alarm_name  = "alarm_rule1"
+  alarm_level = 3
 
   metric {
     namespace = "SYS.ECS"
@@ -158,9 +181,9 @@ resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
     period = 300
     filter = "average"
     comparison_operator = ">"
-    value = 6
+    value = 10
     unit = "B/s"
-    count = 1
+    count = 2
   }
   alarm_action_enabled = false
   alarm_enabled = false
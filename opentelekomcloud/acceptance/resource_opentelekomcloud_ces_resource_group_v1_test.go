@@ -0,0 +1,211 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ces"
+)
+
+func TestAccCESResourceGroupV1_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_ces_resource_group_v1.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCESResourceGroupV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCESResourceGroupV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESResourceGroupV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "group_1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+				),
+			},
+			{
+				Config: testAccCESResourceGroupV1Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESResourceGroupV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "group_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCESResourceGroupV1_tags(t *testing.T) {
+	resourceName := "opentelekomcloud_ces_resource_group_v1.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCESResourceGroupV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCESResourceGroupV1Tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESResourceGroupV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.dimensions.0.name", "instance_id"),
+				),
+			},
+			{
+				Config: testAccCESResourceGroupV1TagsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESResourceGroupV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resources.0.dimensions.0.value",
+						"opentelekomcloud_compute_instance_v2.vm.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCESResourceGroupV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.CesV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CES client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ces_resource_group_v1" {
+			continue
+		}
+
+		if _, err := ces.GetResourceGroup(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("CES resource group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCESResourceGroupV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.CesV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud CES client: %s", err)
+		}
+
+		found, err := ces.GetResourceGroup(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.GroupID != rs.Primary.ID {
+			return fmt.Errorf("CES resource group not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccCESResourceGroupV1Instances = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm" {
+  count = 2
+  name  = "instance_${count.index}"
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccCESResourceGroupV1Basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_resource_group_v1" "group_1" {
+  name = "group_1"
+
+  resources {
+    namespace = "SYS.ECS"
+    dimensions {
+      name  = "instance_id"
+      value = opentelekomcloud_compute_instance_v2.vm[0].id
+    }
+  }
+}
+`, testAccCESResourceGroupV1Instances)
+
+var testAccCESResourceGroupV1Update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_resource_group_v1" "group_1" {
+  name = "group_1_updated"
+
+  dynamic "resources" {
+    for_each = opentelekomcloud_compute_instance_v2.vm[*].id
+    content {
+      namespace = "SYS.ECS"
+      dimensions {
+        name  = "instance_id"
+        value = resources.value
+      }
+    }
+  }
+}
+`, testAccCESResourceGroupV1Instances)
+
+var testAccCESResourceGroupV1TaggedInstances = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm" {
+  count = 2
+  name  = "instance_${count.index}"
+  network {
+    uuid = "%s"
+  }
+  tags = {
+    ces_group = "tf_acc_group_1"
+    index     = count.index
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccCESResourceGroupV1Tags = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_resource_group_v1" "group_1" {
+  name      = "group_1"
+  namespace = "SYS.ECS"
+  tags = {
+    ces_group = "tf_acc_group_1"
+  }
+
+  depends_on = [opentelekomcloud_compute_instance_v2.vm]
+}
+`, testAccCESResourceGroupV1TaggedInstances)
+
+var testAccCESResourceGroupV1TagsUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_resource_group_v1" "group_1" {
+  name      = "group_1"
+  namespace = "SYS.ECS"
+  tags = {
+    ces_group = "tf_acc_group_1"
+    index     = "0"
+  }
+
+  depends_on = [opentelekomcloud_compute_instance_v2.vm]
+}
+`, testAccCESResourceGroupV1TaggedInstances)
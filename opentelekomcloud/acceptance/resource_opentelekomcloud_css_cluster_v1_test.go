@@ -9,8 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 
-	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/css"
 )
 
 func TestAccCssClusterV1_basic(t *testing.T) {
@@ -23,6 +23,63 @@ func TestAccCssClusterV1_basic(t *testing.T) {
 				Config: testAccCssClusterV1_basic(acctest.RandString(10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttr("opentelekomcloud_css_cluster_v1.cluster", "node_config.0.flavor", "css.medium.8"),
+					resource.TestCheckResourceAttr("opentelekomcloud_css_cluster_v1.cluster", "node_config.0.volume.0.size", "40"),
+				),
+			},
+			{
+				ResourceName:            "opentelekomcloud_css_cluster_v1.cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_pass"},
+			},
+		},
+	})
+}
+
+func TestAccCssClusterV1_noAvailabilityZone(t *testing.T) {
+	resourceName := "opentelekomcloud_css_cluster_v1.cluster"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssClusterV1_noAvailabilityZone(acctest.RandString(10)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttrSet(resourceName, "node_config.0.availability_zone"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCssClusterV1_securityAndPublicAccess(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "opentelekomcloud_css_cluster_v1.cluster"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssClusterV1_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttr(resourceName, "security_mode", "false"),
+				),
+			},
+			{
+				Config: testAccCssClusterV1_securityAndPublicAccess(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttr(resourceName, "security_mode", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kibana_public_access.0.bandwidth", "5"),
+					resource.TestCheckResourceAttr(resourceName, "kibana_public_access.0.whitelist_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "kibana_public_access.0.public_ip"),
 				),
 			},
 		},
@@ -56,11 +113,37 @@ resource "opentelekomcloud_css_cluster_v1" "cluster" {
 `, val, OS_NETWORK_ID, OS_VPC_ID, OS_AVAILABILITY_ZONE)
 }
 
+func testAccCssClusterV1_noAvailabilityZone(val string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "terraform_test_security_group%[1]s"
+  description = "terraform security group acceptance test"
+}
+
+resource "opentelekomcloud_css_cluster_v1" "cluster" {
+  expect_node_num = 1
+  name = "terraform_test_cluster%[1]s"
+  node_config {
+    flavor = "css.medium.8"
+    network_info {
+      security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+      network_id = "%s"
+      vpc_id = "%s"
+    }
+    volume {
+      volume_type = "COMMON"
+      size = 40
+    }
+  }
+}
+`, val, OS_NETWORK_ID, OS_VPC_ID)
+}
+
 func testAccCheckCssClusterV1Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	client, err := config.CssV1Client(OS_REGION_NAME)
 	if err != nil {
-		return fmt.Errorf("error creating sdk client, err=%s", err)
+		return fmt.Errorf("error creating CSSv1 client: %s", err)
 	}
 
 	for _, rs := range s.RootModule().Resources {
@@ -68,16 +151,9 @@ func testAccCheckCssClusterV1Destroy(s *terraform.State) error {
 			continue
 		}
 
-		url, err := common.ReplaceVarsForTest(rs, "clusters/{id}")
-		if err != nil {
-			return err
-		}
-		url = client.ServiceURL(url)
-
-		_, err = client.Get(url, nil, &golangsdk.RequestOpts{
-			MoreHeaders: map[string]string{"Content-Type": "application/json"}})
+		_, err := css.GetCluster(client, rs.Primary.ID)
 		if err == nil {
-			return fmt.Errorf("opentelekomcloud_css_cluster_v1 still exists at %s", url)
+			return fmt.Errorf("opentelekomcloud_css_cluster_v1 %s still exists", rs.Primary.ID)
 		}
 	}
 
@@ -89,7 +165,7 @@ func testAccCheckCssClusterV1Exists() resource.TestCheckFunc {
 		config := testAccProvider.Meta().(*cfg.Config)
 		client, err := config.CssV1Client(OS_REGION_NAME)
 		if err != nil {
-			return fmt.Errorf("error creating sdk client, err=%s", err)
+			return fmt.Errorf("error creating CSSv1 client: %s", err)
 		}
 
 		rs, ok := s.RootModule().Resources["opentelekomcloud_css_cluster_v1.cluster"]
@@ -97,14 +173,7 @@ func testAccCheckCssClusterV1Exists() resource.TestCheckFunc {
 			return fmt.Errorf("error checking opentelekomcloud_css_cluster_v1.cluster exist, err=not found this resource")
 		}
 
-		url, err := common.ReplaceVarsForTest(rs, "clusters/{id}")
-		if err != nil {
-			return fmt.Errorf("error checking opentelekomcloud_css_cluster_v1.cluster exist, err=building url failed: %s", err)
-		}
-		url = client.ServiceURL(url)
-
-		_, err = client.Get(url, nil, &golangsdk.RequestOpts{
-			MoreHeaders: map[string]string{"Content-Type": "application/json"}})
+		_, err = css.GetCluster(client, rs.Primary.ID)
 		if err != nil {
 			if _, ok := err.(golangsdk.ErrDefault404); ok {
 				return fmt.Errorf("opentelekomcloud_css_cluster_v1.cluster is not exist")
@@ -114,3 +183,39 @@ func testAccCheckCssClusterV1Exists() resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func testAccCssClusterV1_securityAndPublicAccess(val string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "terraform_test_security_group%[1]s"
+  description = "terraform security group acceptance test"
+}
+
+resource "opentelekomcloud_css_cluster_v1" "cluster" {
+  expect_node_num = 1
+  name = "terraform_test_cluster%[1]s"
+  node_config {
+    flavor = "css.medium.8"
+    network_info {
+      security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+      network_id = "%s"
+      vpc_id = "%s"
+    }
+    volume {
+      volume_type = "COMMON"
+      size = 40
+    }
+    availability_zone = "%s"
+  }
+
+  security_mode = true
+  admin_pass    = "QwertyUI!123$"
+
+  kibana_public_access {
+    bandwidth         = 5
+    whitelist_enabled = true
+    whitelist         = "10.0.0.0/24"
+  }
+}
+`, val, OS_NETWORK_ID, OS_VPC_ID, OS_AVAILABILITY_ZONE)
+}
@@ -2,6 +2,8 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -76,6 +78,81 @@ func TestAccDcsInstancesV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccDcsInstancesV1_extendAndPassword(t *testing.T) {
+	var instance instances.Instance
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Instance_capacity(instanceName, 2, "Hungarian_rapsody"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1InstanceExists("opentelekomcloud_dcs_instance_v1.instance_1", instance),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "capacity", "2"),
+				),
+			},
+			{
+				Config: testAccDcsV1Instance_capacity(instanceName, 4, "Hungarian_rapsody_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1InstanceExists("opentelekomcloud_dcs_instance_v1.instance_1", instance),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "capacity", "4"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "status", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDcsInstancesV1_configuration(t *testing.T) {
+	var instance instances.Instance
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Instance_configuration(instanceName, "volatile-lru"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1InstanceExists("opentelekomcloud_dcs_instance_v1.instance_1", instance),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "configuration.maxmemory-policy", "volatile-lru"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "configuration.timeout", "100"),
+				),
+			},
+			{
+				Config: testAccDcsV1Instance_configuration(instanceName, "allkeys-lru"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "configuration.maxmemory-policy", "allkeys-lru"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDcsInstancesV1_unknownConfiguration(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDcsV1Instance_unknownConfiguration(instanceName),
+				ExpectError: regexp.MustCompile(`unsupported configuration parameters for Redis 3.0: maxmemory-polcy`),
+			},
+			{
+				Config:      testAccDcsV1Instance_streamConfiguration(instanceName),
+				ExpectError: regexp.MustCompile(`unsupported configuration parameters for Redis 3.0: stream-node-max-bytes`),
+			},
+		},
+	})
+}
+
 func testAccCheckDcsV1InstanceDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	dcsClient, err := config.DcsV1Client(OS_REGION_NAME)
@@ -267,3 +344,74 @@ resource "opentelekomcloud_dcs_instance_v1" "instance_1" {
 }
 	`, OS_AVAILABILITY_ZONE, instanceName, OS_VPC_ID, OS_NETWORK_ID)
 }
+
+func testAccDcsV1Instance_capacity(instanceName string, capacity int, password string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "secgroup_1"
+}
+data "opentelekomcloud_dcs_az_v1" "az_1" {
+  port = "8002"
+  code = "%s"
+}
+data "opentelekomcloud_dcs_product_v1" "product_1" {
+  spec_code = "dcs.master_standby"
+}
+resource "opentelekomcloud_dcs_instance_v1" "instance_1" {
+  name              = "%s"
+  engine_version    = "3.0"
+  password          = "%s"
+  engine            = "Redis"
+  capacity          = %d
+  vpc_id            = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  subnet_id         = "%s"
+  available_zones   = [data.opentelekomcloud_dcs_az_v1.az_1.id]
+  product_id        = data.opentelekomcloud_dcs_product_v1.product_1.id
+}
+	`, OS_AVAILABILITY_ZONE, instanceName, password, capacity, OS_VPC_ID, OS_NETWORK_ID)
+}
+
+func testAccDcsV1Instance_configuration(instanceName string, policy string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "secgroup_1"
+}
+data "opentelekomcloud_dcs_az_v1" "az_1" {
+  port = "8002"
+  code = "%s"
+}
+data "opentelekomcloud_dcs_product_v1" "product_1" {
+  spec_code = "dcs.master_standby"
+}
+resource "opentelekomcloud_dcs_instance_v1" "instance_1" {
+  name              = "%s"
+  engine_version    = "3.0"
+  password          = "Hungarian_rapsody"
+  engine            = "Redis"
+  capacity          = 2
+  vpc_id            = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  subnet_id         = "%s"
+  available_zones   = [data.opentelekomcloud_dcs_az_v1.az_1.id]
+  product_id        = data.opentelekomcloud_dcs_product_v1.product_1.id
+
+  configuration = {
+    "maxmemory-policy" = "%s"
+    "timeout"          = "100"
+  }
+}
+	`, OS_AVAILABILITY_ZONE, instanceName, OS_VPC_ID, OS_NETWORK_ID, policy)
+}
+
+func testAccDcsV1Instance_unknownConfiguration(instanceName string) string {
+	return strings.Replace(testAccDcsV1Instance_configuration(instanceName, "volatile-lru"),
+		`"maxmemory-policy"`, `"maxmemory-polcy"`, 1)
+}
+
+func testAccDcsV1Instance_streamConfiguration(instanceName string) string {
+	return strings.Replace(testAccDcsV1Instance_configuration(instanceName, "volatile-lru"),
+		`"timeout"          = "100"`, `"stream-node-max-bytes" = "4096"`, 1)
+}
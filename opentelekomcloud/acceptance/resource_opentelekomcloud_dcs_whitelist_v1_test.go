@@ -0,0 +1,153 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccDcsWhitelistV1_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_dcs_whitelist_v1.whitelist_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsV1WhitelistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Whitelist_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1WhitelistExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_whitelist", "true"),
+					resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.ip_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccDcsV1Whitelist_update(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1WhitelistExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "group.1.group_name", "admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcsV1WhitelistDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	dcsClient, err := config.DcsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating DCSv2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dcs_whitelist_v1" {
+			continue
+		}
+
+		wl, err := whitelists.Get(dcsClient, rs.Primary.ID).Extract()
+		if err == nil && wl.Enable {
+			return fmt.Errorf("DCS whitelist still enabled")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDcsV1WhitelistExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		dcsClient, err := config.DcsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating DCSv2 client: %s", err)
+		}
+
+		wl, err := whitelists.Get(dcsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error getting DCS whitelist: %s", err)
+		}
+		if len(wl.Groups) == 0 {
+			return fmt.Errorf("DCS whitelist not found")
+		}
+		return nil
+	}
+}
+
+const testAccDcsV1WhitelistInstance = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_1"
+  description = "secgroup_1"
+}
+data "opentelekomcloud_dcs_az_v1" "az_1" {
+  port = "8002"
+  code = "%s"
+}
+data "opentelekomcloud_dcs_product_v1" "product_1" {
+  spec_code = "redis.ha.xu1.large.r2.2-h"
+}
+resource "opentelekomcloud_dcs_instance_v1" "instance_1" {
+  name              = "%s"
+  engine_version    = "5.0"
+  password          = "Hungarian_rapsody"
+  engine            = "Redis"
+  capacity          = 2
+  vpc_id            = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  subnet_id         = "%s"
+  available_zones   = [data.opentelekomcloud_dcs_az_v1.az_1.id]
+  product_id        = data.opentelekomcloud_dcs_product_v1.product_1.id
+}
+`
+
+func testAccDcsV1Whitelist_basic(instanceName string) string {
+	return fmt.Sprintf(testAccDcsV1WhitelistInstance+`
+resource "opentelekomcloud_dcs_whitelist_v1" "whitelist_1" {
+  instance_id = opentelekomcloud_dcs_instance_v1.instance_1.id
+
+  group {
+    group_name = "app"
+    ip_list    = ["10.10.10.1", "10.10.20.0/24"]
+  }
+}
+`, OS_AVAILABILITY_ZONE, instanceName, OS_VPC_ID, OS_NETWORK_ID)
+}
+
+func testAccDcsV1Whitelist_update(instanceName string) string {
+	return fmt.Sprintf(testAccDcsV1WhitelistInstance+`
+resource "opentelekomcloud_dcs_whitelist_v1" "whitelist_1" {
+  instance_id = opentelekomcloud_dcs_instance_v1.instance_1.id
+
+  group {
+    group_name = "app"
+    ip_list    = ["10.10.10.1", "10.10.20.0/24"]
+  }
+
+  group {
+    group_name = "admin"
+    ip_list    = ["192.168.0.10"]
+  }
+}
+`, OS_AVAILABILITY_ZONE, instanceName, OS_VPC_ID, OS_NETWORK_ID)
+}
@@ -30,6 +30,35 @@ func TestAccDDSV3Instance_basic(t *testing.T) {
 	})
 }
 
+func TestAccDDSV3Instance_updateFlavor(t *testing.T) {
+	resourceName := "opentelekomcloud_dds_instance_v3.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccDDSInstanceV3Config_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "1"),
+				),
+			},
+			{
+				Config: TestAccDDSInstanceV3Config_updateFlavor,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flavor.0.size", "30"),
+					resource.TestCheckResourceAttr(resourceName, "flavor.0.spec_code", "dds.mongodb.s2.large.4.repset"),
+					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.start_time", "10:00-11:00"),
+					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDDSV3Instance_minConfig(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -168,3 +197,34 @@ resource "opentelekomcloud_dds_instance_v3" "instance" {
     spec_code = "dds.mongodb.s2.medium.4.repset"
   }
 }`, OS_AVAILABILITY_ZONE, OS_VPC_ID, OS_NETWORK_ID)
+
+var TestAccDDSInstanceV3Config_updateFlavor = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg_acc" {
+  name = "secgroup_acc"
+}
+resource "opentelekomcloud_dds_instance_v3" "instance" {
+  name              = "dds-instance"
+  availability_zone = "%s"
+  region            = "%s"
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg_acc.id
+  password          = "5ecuredPa55w0rd@"
+  mode              = "ReplicaSet"
+  flavor {
+    type = "replica"
+    num = 1
+    storage = "ULTRAHIGH"
+    size = 30
+    spec_code = "dds.mongodb.s2.large.4.repset"
+  }
+  backup_strategy {
+    start_time = "10:00-11:00"
+    keep_days = "3"
+  }
+}`, OS_AVAILABILITY_ZONE, OS_REGION_NAME, OS_VPC_ID, OS_NETWORK_ID)
@@ -0,0 +1,139 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dms"
+)
+
+func TestAccDmsTopicsV1_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_instance_%s", acctest.RandString(5))
+	var topicName = fmt.Sprintf("topic_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_dms_topic_v1.topic_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsV1TopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsV1Topic_basic(instanceName, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsV1TopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", topicName),
+					resource.TestCheckResourceAttr(resourceName, "partition", "3"),
+					resource.TestCheckResourceAttr(resourceName, "retention_time", "72"),
+				),
+			},
+			{
+				Config: testAccDmsV1Topic_update(instanceName, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsV1TopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retention_time", "48"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDmsV1TopicImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccDmsV1TopicImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckDmsV1TopicDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	dmsClient, err := config.DmsV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DMSv1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_topic_v1" {
+			continue
+		}
+
+		topicList, err := dms.ListTopics(dmsClient, rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			continue
+		}
+		for _, topic := range topicList.Topics {
+			if topic.Name == rs.Primary.ID {
+				return fmt.Errorf("the DMS topic still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsV1TopicExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		dmsClient, err := config.DmsV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud DMSv1 client: %s", err)
+		}
+
+		topicList, err := dms.ListTopics(dmsClient, rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			return fmt.Errorf("Error listing OpenTelekomCloud DMS topics: %s", err)
+		}
+		for _, topic := range topicList.Topics {
+			if topic.Name == rs.Primary.ID {
+				return nil
+			}
+		}
+		return fmt.Errorf("the DMS topic not found")
+	}
+}
+
+func testAccDmsV1Topic_basic(instanceName, topicName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_topic_v1" "topic_1" {
+  instance_id = opentelekomcloud_dms_instance_v1.instance_1.id
+  name        = "%s"
+}
+`, testAccDmsV1Instance_KafkaInstance(instanceName), topicName)
+}
+
+func testAccDmsV1Topic_update(instanceName, topicName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_topic_v1" "topic_1" {
+  instance_id    = opentelekomcloud_dms_instance_v1.instance_1.id
+  name           = "%s"
+  partition      = 5
+  retention_time = 48
+}
+`, testAccDmsV1Instance_KafkaInstance(instanceName), topicName)
+}
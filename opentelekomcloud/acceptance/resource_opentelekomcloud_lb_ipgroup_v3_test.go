@@ -0,0 +1,124 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+)
+
+func TestAccLBV3IPGroup_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_ipgroup_v3.ipgroup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV3IPGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3IPGroupConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3IPGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "ipgroup_1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccLBV3IPGroupConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3IPGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "ipgroup_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3IPGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_ipgroup_v3" {
+			continue
+		}
+
+		if _, err := elb.GetIPGroupV3(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("IP group still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3IPGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+		}
+
+		found, err := elb.GetIPGroupV3(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("IP group not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccLBV3IPGroupConfigBasic = `
+resource "opentelekomcloud_lb_ipgroup_v3" "ipgroup_1" {
+  name        = "ipgroup_1"
+  description = "some interesting description"
+
+  ip_list {
+    ip          = "192.168.10.10"
+    description = "first"
+  }
+}
+`
+
+const testAccLBV3IPGroupConfigUpdate = `
+resource "opentelekomcloud_lb_ipgroup_v3" "ipgroup_1" {
+  name        = "ipgroup_1_updated"
+  description = "some interesting description"
+
+  ip_list {
+    ip          = "192.168.10.10"
+    description = "first"
+  }
+
+  ip_list {
+    ip          = "192.168.100.0/24"
+    description = "second"
+  }
+}
+`
@@ -42,6 +42,38 @@ func TestAccLBV2L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Policy_fixedResponse(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+	resourceName := "opentelekomcloud_lb_l7policy_v2.l7policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLBV2L7PolicyConfig_fixedResponse,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "action", "FIXED_RESPONSE"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.status_code", "503"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.content_type", "application/json"),
+				),
+			},
+			{
+				Config: testAccCheckLBV2L7PolicyConfig_redirectURL,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url_config.0.protocol", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url_config.0.status_code", "301"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	lbClient, err := config.NetworkingV2Client(OS_REGION_NAME)
@@ -124,3 +156,55 @@ resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
   redirect_pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
 }
 `, OS_SUBNET_ID)
+
+var testAccCheckLBV2L7PolicyConfig_fixedResponse = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "FIXED_RESPONSE"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+
+  fixed_response_config {
+    status_code  = "503"
+    content_type = "application/json"
+    message_body = "{\"status\": \"maintenance\"}"
+  }
+}
+`, OS_SUBNET_ID)
+
+var testAccCheckLBV2L7PolicyConfig_redirectURL = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "REDIRECT_TO_URL"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+
+  redirect_url_config {
+    protocol    = "HTTPS"
+    port        = "443"
+    status_code = "301"
+  }
+}
+`, OS_SUBNET_ID)
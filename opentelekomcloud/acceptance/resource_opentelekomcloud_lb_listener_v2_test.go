@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -32,6 +33,49 @@ func TestAccLBV2Listener_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", "listener_1_updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-update"),
+					resource.TestCheckResourceAttr(resourceName, "keepalive_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "client_timeout", "100"),
+					resource.TestCheckResourceAttr(resourceName, "member_timeout", "100"),
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.forwarded_elb_ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.forwarded_host", "true"),
+				),
+			},
+			{
+				Config: TestAccLBV2ListenerConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Listener_protocolOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2ListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLBV2ListenerConfigProtocolOption("TCP", "client_timeout = 100"),
+				ExpectError: regexp.MustCompile(`client_timeout can only be used with HTTP or TERMINATED_HTTPS listener`),
+			},
+			{
+				Config:      testAccLBV2ListenerConfigProtocolOption("UDP", "keepalive_timeout = 100"),
+				ExpectError: regexp.MustCompile(`keepalive_timeout can't be used with UDP listener`),
+			},
+			{
+				Config:      testAccLBV2ListenerConfigProtocolOption("HTTP", "http2_enable = true"),
+				ExpectError: regexp.MustCompile(`http2_enable can only be used with TERMINATED_HTTPS listener`),
+			},
+			{
+				Config: testAccLBV2ListenerConfigProtocolOption("TCP", ""),
+			},
+			{
+				// timeouts returned by the API for TCP listener don't fail the update
+				Config: testAccLBV2ListenerConfigProtocolOption("TCP", `description = "updated"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_lb_listener_v2.listener_1", "description", "updated"),
 				),
 			},
 		},
@@ -162,6 +206,14 @@ resource "opentelekomcloud_lb_listener_v2" "listener_1" {
   #connection_limit = 100
   admin_state_up    = "true"
   loadbalancer_id   = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  keepalive_timeout = 120
+  client_timeout    = 100
+  member_timeout    = 100
+
+  insert_headers {
+    forwarded_elb_ip = true
+    forwarded_host   = true
+  }
 
   tags = {
     muh = "value-update"
@@ -175,6 +227,24 @@ resource "opentelekomcloud_lb_listener_v2" "listener_1" {
 }
 `, OS_SUBNET_ID)
 
+func testAccLBV2ListenerConfigProtocolOption(protocol, option string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "%s"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+
+  %s
+}
+`, OS_SUBNET_ID, protocol, option)
+}
+
 var TestAccLBV2ListenerConfig_http2 = fmt.Sprintf(`
 resource "opentelekomcloud_lb_certificate_v2" "certificate_tls" {
   name        = "certificate_tls"
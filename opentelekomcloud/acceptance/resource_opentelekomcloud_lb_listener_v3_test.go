@@ -0,0 +1,133 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+)
+
+func TestAccLBV3Listener_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_listener_v3.listener_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV3ListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3ListenerConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3ListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "listener_1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_group.#", "0"),
+				),
+			},
+			{
+				Config: testAccLBV3ListenerConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3ListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "listener_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "http2_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ip_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_group.0.type", "black"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3ListenerDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_listener_v3" {
+			continue
+		}
+
+		if _, err := elb.GetListenerV3(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("listener still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3ListenerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+		}
+
+		found, err := elb.GetListenerV3(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("listener not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV3ListenerConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+`, testAccLBV3LoadBalancerConfig)
+
+var testAccLBV3ListenerConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_ipgroup_v3" "ipgroup_1" {
+  name = "ipgroup_1"
+
+  ip_list {
+    ip = "192.168.10.10"
+  }
+}
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1_updated"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  http2_enable    = true
+
+  ip_group {
+    id   = opentelekomcloud_lb_ipgroup_v3.ipgroup_1.id
+    type = "black"
+  }
+}
+`, testAccLBV3LoadBalancerConfig)
@@ -0,0 +1,135 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+)
+
+func TestAccLBV3LoadBalancer_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV3LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3LoadBalancerConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3LoadBalancerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "kuh"),
+				),
+			},
+			{
+				Config: testAccLBV3LoadBalancerConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3LoadBalancerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "loadbalancer_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "admin_state_up", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3LoadBalancerDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_loadbalancer_v3" {
+			continue
+		}
+
+		if _, err := elb.GetLoadBalancerV3(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("loadbalancer still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3LoadBalancerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+		}
+
+		found, err := elb.GetLoadBalancerV3(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("loadbalancer not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV3LoadBalancerConfig = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name               = "loadbalancer_1"
+  router_id          = "%s"
+  subnet_id          = "%s"
+  network_ids        = ["%s"]
+  availability_zones = ["%s"]
+}
+`, OS_VPC_ID, OS_SUBNET_ID, OS_NETWORK_ID, OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LoadBalancerConfigBasic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name               = "loadbalancer_1"
+  router_id          = "%[1]s"
+  subnet_id          = "%[2]s"
+  network_ids        = ["%[3]s"]
+  availability_zones = ["%[4]s"]
+
+  tags = {
+    muh = "kuh"
+  }
+}
+`, OS_VPC_ID, OS_SUBNET_ID, OS_NETWORK_ID, OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LoadBalancerConfigUpdate = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name               = "loadbalancer_1_updated"
+  router_id          = "%[1]s"
+  subnet_id          = "%[2]s"
+  network_ids        = ["%[3]s"]
+  availability_zones = ["%[4]s"]
+  admin_state_up     = false
+
+  tags = {
+    muh = "value-update"
+  }
+}
+`, OS_VPC_ID, OS_SUBNET_ID, OS_NETWORK_ID, OS_AVAILABILITY_ZONE)
@@ -0,0 +1,158 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+)
+
+func TestAccLBV3Member_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_member_v3.member_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV3MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3MemberConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "weight", "1"),
+				),
+			},
+			{
+				Config: testAccLBV3MemberConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3MemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "weight", "10"),
+					resource.TestCheckResourceAttr(resourceName, "name", "member_1_updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccLBV3MemberImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3MemberDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_member_v3" {
+			continue
+		}
+
+		if _, err := elb.GetMemberV3(client, rs.Primary.Attributes["pool_id"], rs.Primary.ID); err == nil {
+			return fmt.Errorf("member still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3MemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+		}
+
+		found, err := elb.GetMemberV3(client, rs.Primary.Attributes["pool_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("member not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccLBV3MemberImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["pool_id"], rs.Primary.ID), nil
+	}
+}
+
+var testAccLBV3MemberConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name        = "pool_1"
+  listener_id = opentelekomcloud_lb_listener_v3.listener_1.id
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_member_v3" "member_1" {
+  name          = "member_1"
+  pool_id       = opentelekomcloud_lb_pool_v3.pool_1.id
+  address       = "192.168.0.10"
+  protocol_port = 8080
+  subnet_id     = "%s"
+}
+`, testAccLBV3LoadBalancerConfig, OS_SUBNET_ID)
+
+var testAccLBV3MemberConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name        = "pool_1"
+  listener_id = opentelekomcloud_lb_listener_v3.listener_1.id
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_member_v3" "member_1" {
+  name          = "member_1_updated"
+  pool_id       = opentelekomcloud_lb_pool_v3.pool_1.id
+  address       = "192.168.0.10"
+  protocol_port = 8080
+  subnet_id     = "%s"
+  weight        = 10
+}
+`, testAccLBV3LoadBalancerConfig, OS_SUBNET_ID)
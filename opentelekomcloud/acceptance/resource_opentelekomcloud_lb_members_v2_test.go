@@ -0,0 +1,173 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccLBV2Members_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_members_v2.members_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2MembersConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "2"),
+				),
+			},
+			{
+				Config: testAccLBV2MembersConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersCount(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2MembersDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_members_v2" {
+			continue
+		}
+
+		allPages, err := pools.ListMembers(networkingClient, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			// the pool is already deleted
+			continue
+		}
+		members, err := pools.ExtractMembers(allPages)
+		if err != nil {
+			return err
+		}
+		if len(members) != 0 {
+			return fmt.Errorf("members of pool %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2MembersCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		allPages, err := pools.ListMembers(networkingClient, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return err
+		}
+		members, err := pools.ExtractMembers(allPages)
+		if err != nil {
+			return err
+		}
+		if len(members) != count {
+			return fmt.Errorf("expected %d members, got %d", count, len(members))
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV2MembersConfigPool = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+`, OS_SUBNET_ID)
+
+var testAccLBV2MembersConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_members_v2" "members_1" {
+  pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
+
+  member {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    subnet_id     = "%[2]s"
+  }
+
+  member {
+    address       = "192.168.0.11"
+    protocol_port = 8080
+    subnet_id     = "%[2]s"
+  }
+}
+`, testAccLBV2MembersConfigPool, OS_SUBNET_ID)
+
+var testAccLBV2MembersConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_members_v2" "members_1" {
+  pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
+
+  member {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    weight        = 10
+    subnet_id     = "%[2]s"
+  }
+
+  member {
+    address       = "192.168.0.11"
+    protocol_port = 8080
+    subnet_id     = "%[2]s"
+  }
+
+  member {
+    address       = "192.168.0.12"
+    protocol_port = 8080
+    subnet_id     = "%[2]s"
+  }
+}
+`, testAccLBV2MembersConfigPool, OS_SUBNET_ID)
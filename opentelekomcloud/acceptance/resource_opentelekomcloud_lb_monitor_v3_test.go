@@ -0,0 +1,151 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+)
+
+func TestAccLBV3Monitor_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_monitor_v3.monitor_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV3MonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3MonitorConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3MonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delay", "20"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "3"),
+				),
+			},
+			{
+				Config: testAccLBV3MonitorConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3MonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delay", "30"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "5"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "/health"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3MonitorDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_monitor_v3" {
+			continue
+		}
+
+		if _, err := elb.GetMonitorV3(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("monitor still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3MonitorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+		}
+
+		found, err := elb.GetMonitorV3(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("monitor not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV3MonitorConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name        = "pool_1"
+  listener_id = opentelekomcloud_lb_listener_v3.listener_1.id
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_monitor_v3" "monitor_1" {
+  name        = "monitor_1"
+  pool_id     = opentelekomcloud_lb_pool_v3.pool_1.id
+  type        = "HTTP"
+  delay       = 20
+  timeout     = 10
+  max_retries = 3
+}
+`, testAccLBV3LoadBalancerConfig)
+
+var testAccLBV3MonitorConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name        = "pool_1"
+  listener_id = opentelekomcloud_lb_listener_v3.listener_1.id
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_monitor_v3" "monitor_1" {
+  name        = "monitor_1"
+  pool_id     = opentelekomcloud_lb_pool_v3.pool_1.id
+  type        = "HTTP"
+  delay       = 30
+  timeout     = 15
+  max_retries = 5
+  url_path    = "/health"
+}
+`, testAccLBV3LoadBalancerConfig)
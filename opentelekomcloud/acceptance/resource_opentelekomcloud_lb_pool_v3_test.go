@@ -0,0 +1,135 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+)
+
+func TestAccLBV3Pool_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_pool_v3.pool_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV3PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3PoolConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3PoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "pool_1"),
+					resource.TestCheckResourceAttr(resourceName, "lb_method", "ROUND_ROBIN"),
+				),
+			},
+			{
+				Config: testAccLBV3PoolConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3PoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "pool_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "lb_method", "LEAST_CONNECTIONS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV3PoolDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.ElbV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_pool_v3" {
+			continue
+		}
+
+		if _, err := elb.GetPoolV3(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("pool still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV3PoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+		}
+
+		found, err := elb.GetPoolV3(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("pool not found")
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV3PoolConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name        = "pool_1"
+  listener_id = opentelekomcloud_lb_listener_v3.listener_1.id
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+}
+`, testAccLBV3LoadBalancerConfig)
+
+var testAccLBV3PoolConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name        = "pool_1_updated"
+  listener_id = opentelekomcloud_lb_listener_v3.listener_1.id
+  protocol    = "HTTP"
+  lb_method   = "LEAST_CONNECTIONS"
+
+  persistence {
+    type = "SOURCE_IP"
+  }
+}
+`, testAccLBV3LoadBalancerConfig)
@@ -16,6 +16,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -43,6 +44,61 @@ func TestAccNatDnat_basic(t *testing.T) {
 	})
 }
 
+func TestAccNatDnat_portRange(t *testing.T) {
+	resourceName := "opentelekomcloud_nat_dnat_rule_v2.dnat"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatDnatDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatDnat_portRange(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatDnatExists(),
+					resource.TestCheckResourceAttr(resourceName, "internal_service_port_range", "1000-1010"),
+					resource.TestCheckResourceAttr(resourceName, "external_service_port_range", "2000-2010"),
+					resource.TestCheckResourceAttr(resourceName, "description", "port range rule"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNatDnat_invalidPortRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNatDnat_invalidPortRange("1010-1000", "2000-2010"),
+				ExpectError: regexp.MustCompile(`must be a port range with 1 <= start <= end <= 65535`),
+			},
+			{
+				Config:      testAccNatDnat_invalidPortRange("1000-1010", "65530-70000"),
+				ExpectError: regexp.MustCompile(`must be a port range with 1 <= start <= end <= 65535`),
+			},
+			{
+				Config:      testAccNatDnat_invalidPortRange("1000-1010", "2000-2020"),
+				ExpectError: regexp.MustCompile(`must have the same length`),
+			},
+		},
+	})
+}
+
+func testAccNatDnat_invalidPortRange(internalRange, externalRange string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
+  floating_ip_id              = "2a4a7c8e-3c4c-4b3b-a0a4-7a2bd2d2d2d2"
+  nat_gateway_id              = "3b5b8d9f-4d5d-4c4c-b1b5-8b3ce3e3e3e3"
+  private_ip                  = "192.168.199.10"
+  protocol                    = "tcp"
+  internal_service_port_range = "%s"
+  external_service_port_range = "%s"
+}
+`, internalRange, externalRange)
+}
+
 func testAccNatDnat_basic() string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_networking_router_v2" "router_1" {
@@ -103,6 +159,67 @@ resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
 `, OS_AVAILABILITY_ZONE)
 }
 
+func testAccNatDnat_portRange() string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+}
+
+resource "opentelekomcloud_networking_router_interface_v2" "int_1" {
+  subnet_id = opentelekomcloud_networking_subnet_v2.subnet_1.id
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+}
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {
+}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_gw" {
+  name   = "nat_gw"
+  description = "test for terraform"
+  spec = "1"
+  internal_network_id = opentelekomcloud_networking_network_v2.network_1.id
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+  depends_on = [opentelekomcloud_networking_router_interface_v2.int_1]
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  availability_zone = "%s"
+  metadata = {
+    foo = "bar"
+  }
+  network {
+    uuid = opentelekomcloud_networking_network_v2.network_1.id
+  }
+ depends_on = [opentelekomcloud_networking_router_interface_v2.int_1]
+}
+
+resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
+  floating_ip_id = opentelekomcloud_networking_floatingip_v2.fip_1.id
+  nat_gateway_id = opentelekomcloud_nat_gateway_v2.nat_gw.id
+  private_ip = opentelekomcloud_compute_instance_v2.instance_1.network.0.fixed_ip_v4
+  internal_service_port_range = "1000-1010"
+  protocol = "tcp"
+  external_service_port_range = "2000-2010"
+  description = "port range rule"
+  depends_on = [opentelekomcloud_compute_instance_v2.instance_1]
+}
+`, OS_AVAILABILITY_ZONE)
+}
+
 func testAccCheckNatDnatDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	client, err := config.NatV2Client(OS_REGION_NAME)
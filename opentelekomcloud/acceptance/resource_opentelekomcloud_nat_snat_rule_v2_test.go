@@ -43,6 +43,25 @@ func TestAccNatSnatRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccNatSnatRule_multipleEIPs(t *testing.T) {
+	resourceName := "opentelekomcloud_nat_snat_rule_v2.snat_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatV2SnatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatV2SnatRule_multipleEIPs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatV2SnatRuleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "floating_ip_address"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNatV2SnatRuleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	natClient, err := config.NatV2Client(OS_REGION_NAME)
@@ -135,3 +154,51 @@ resource "opentelekomcloud_nat_snat_rule_v2" "snat_1" {
   source_type = 0
 }
 `
+
+const testAccNatV2SnatRule_multipleEIPs = `
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  cidr = "192.168.0.0/16"
+  ip_version = 4
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+}
+
+resource "opentelekomcloud_networking_router_interface_v2" "int_1" {
+  subnet_id = opentelekomcloud_networking_subnet_v2.subnet_1.id
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+}
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {
+}
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_2" {
+}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
+  name   = "nat_1"
+  description = "test for terraform"
+  spec = "1"
+  internal_network_id = opentelekomcloud_networking_network_v2.network_1.id
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+  depends_on = ["opentelekomcloud_networking_router_interface_v2.int_1"]
+}
+
+resource "opentelekomcloud_nat_snat_rule_v2" "snat_1" {
+  nat_gateway_id = opentelekomcloud_nat_gateway_v2.nat_1.id
+  floating_ip_id = join(",", [
+    opentelekomcloud_networking_floatingip_v2.fip_1.id,
+    opentelekomcloud_networking_floatingip_v2.fip_2.id,
+  ])
+  cidr = "192.168.0.0/24"
+  source_type = 0
+}
+`
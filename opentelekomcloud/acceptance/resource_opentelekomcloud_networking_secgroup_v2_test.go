@@ -57,6 +57,35 @@ func TestAccNetworkingV2SecGroup_noDefaultRules(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroup_rules(t *testing.T) {
+	var securityGroup groups.SecGroup
+	resourceName := "opentelekomcloud_networking_secgroup_v2.secgroup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroup_rules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(resourceName, &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 3),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroup_rulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(resourceName, &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2SecGroup_timeout(t *testing.T) {
 	var securityGroup groups.SecGroup
 
@@ -172,3 +201,55 @@ resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
   }
 }
 `
+
+const testAccNetworkingV2SecGroup_rules = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "security_group_rules"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 80
+    port_range_max   = 80
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroup_rulesUpdate = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "security_group_rules"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 443
+    port_range_max   = 443
+    remote_ip_prefix = "0.0.0.0/0"
+    description      = "HTTPS"
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+}
+`
@@ -172,6 +172,34 @@ func TestAccRdsInstanceV3_templateConfigCheck(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstanceV3_maintenanceWindow(t *testing.T) {
+	postfix := acctest.RandString(3)
+	var rdsInstance instances.RdsInstanceResponse
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceV3_maintenanceWindow(postfix, "22:00", "02:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists("opentelekomcloud_rds_instance_v3.instance", &rdsInstance),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_instance_v3.instance", "maintenance_window.0.start_time", "22:00"),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_instance_v3.instance", "maintenance_window.0.end_time", "02:00"),
+				),
+			},
+			{
+				Config: testAccRdsInstanceV3_maintenanceWindow(postfix, "01:00", "05:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_instance_v3.instance", "maintenance_window.0.start_time", "01:00"),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_instance_v3.instance", "maintenance_window.0.end_time", "05:00"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRdsInstanceV3_invalidDbVersion(t *testing.T) {
 	postfix := acctest.RandString(3)
 
@@ -531,6 +559,8 @@ resource "opentelekomcloud_rds_instance_v3" "instance" {
   }
   flavor         = "rds.pg.c2.medium"
   param_group_id = opentelekomcloud_rds_parametergroup_v3.pg2.id
+
+  reboot_on_parameter_change = true
 }
 `, postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
 }
@@ -561,3 +591,34 @@ resource "opentelekomcloud_rds_instance_v3" "instance" {
 }
 `, postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
 }
+
+func testAccRdsInstanceV3_maintenanceWindow(postfix, startTime, endTime string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-rds-test"
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "Postgres!120521"
+    type     = "PostgreSQL"
+    version  = "10"
+  }
+  security_group_id  = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id          = "%s"
+  vpc_id             = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.pg.c2.medium"
+
+  maintenance_window {
+    start_time = "%s"
+    end_time   = "%s"
+  }
+}
+`, postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID, startTime, endTime)
+}
@@ -0,0 +1,242 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccVpcBandWidthV2_basic(t *testing.T) {
+	var bandwidth bandwidths.BandWidth
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_vpc_bandwidth_v2.bandwidth_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcBandWidthV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcBandWidthV2_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcBandWidthV2Exists(resourceName, &bandwidth),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "share_type", "WHOLE"),
+				),
+			},
+			{
+				Config: testAccVpcBandWidthV2_basic(rName+"-updated", 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &bandwidth.ID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "size", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVpcBandWidthAssociateV2_basic(t *testing.T) {
+	var eip eips.PublicIp
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	eipName := "opentelekomcloud_vpc_eip_v1.eip_1"
+	resourceName := "opentelekomcloud_vpc_bandwidth_associate_v2.associate_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcBandWidthV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcBandWidthAssociateV2_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists(eipName, &eip),
+					resource.TestCheckResourceAttr(resourceName, "floating_ips.#", "1"),
+					testAccCheckVpcV1EIPShareType(&eip, "WHOLE"),
+				),
+			},
+			{
+				Config: testAccVpcBandWidthAssociateV2_removed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(eipName, "id", &eip.ID),
+					testAccCheckVpcV1EIPExists(eipName, &eip),
+					testAccCheckVpcV1EIPShareType(&eip, "PER"),
+				),
+				// the dedicated bandwidth created on removal gets a generated name,
+				// which is updated in place by the next apply
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVpcBandWidthAssociateV2_removed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(eipName, "id", &eip.ID),
+					resource.TestCheckResourceAttr(eipName, "bandwidth.0.name", rName+"-dedicated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcBandWidthV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpc_bandwidth_v2" {
+			continue
+		}
+
+		_, err := bandwidths.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("shared bandwidth still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcBandWidthV2Exists(n string, bandwidth *bandwidths.BandWidth) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+		}
+
+		found, err := bandwidths.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("shared bandwidth not found")
+		}
+
+		*bandwidth = found
+
+		return nil
+	}
+}
+
+func testAccCheckVpcV1EIPShareType(eip *eips.PublicIp, shareType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if eip.BandwidthShareType != shareType {
+			return fmt.Errorf("unexpected bandwidth share type of EIP %s. Expected %s, got %s",
+				eip.ID, shareType, eip.BandwidthShareType)
+		}
+		return nil
+	}
+}
+
+func TestAccVpcBandWidthAssociateV2_shared(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcBandWidthV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcBandWidthAssociateV2_shared(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_vpc_bandwidth_associate_v2.associate_1", "floating_ips.#", "1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_vpc_bandwidth_associate_v2.associate_2", "floating_ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcBandWidthV2_basic(rName string, size int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "%s"
+  size = %d
+}
+`, rName, size)
+}
+
+func testAccVpcBandWidthAssociateV2_base(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "%s"
+  size = 10
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name        = "%s-dedicated"
+    size        = 1
+    share_type  = "PER"
+    charge_mode = "bandwidth"
+  }
+}
+`, rName, rName)
+}
+
+func testAccVpcBandWidthAssociateV2_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_bandwidth_associate_v2" "associate_1" {
+  bandwidth    = opentelekomcloud_vpc_bandwidth_v2.bandwidth_1.id
+  floating_ips = [opentelekomcloud_vpc_eip_v1.eip_1.id]
+}
+`, testAccVpcBandWidthAssociateV2_base(rName))
+}
+
+func testAccVpcBandWidthAssociateV2_removed(rName string) string {
+	return testAccVpcBandWidthAssociateV2_base(rName)
+}
+
+func testAccVpcBandWidthAssociateV2_shared(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_2" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name        = "%s-dedicated-2"
+    size        = 1
+    share_type  = "PER"
+    charge_mode = "bandwidth"
+  }
+}
+
+resource "opentelekomcloud_vpc_bandwidth_associate_v2" "associate_2" {
+  bandwidth    = opentelekomcloud_vpc_bandwidth_v2.bandwidth_1.id
+  floating_ips = [opentelekomcloud_vpc_eip_v1.eip_2.id]
+}
+`, testAccVpcBandWidthAssociateV2_basic(rName), rName)
+}
@@ -49,6 +49,12 @@ func TestAccOTCVpcSubnetV1_basic(t *testing.T) {
 						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ntp_addresses", "10.100.0.35,10.100.0.36"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_vpc_subnet_v1.subnet_1", "tags.key", "value_update"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ipv6_enable", "true"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ipv6_cidr"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ipv6_gateway"),
 				),
 			},
 		},
@@ -159,6 +165,7 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
   availability_zone = "eu-de-02"
   ntp_addresses = "10.100.0.35,10.100.0.36"
+  ipv6_enable = true
 
   tags = {
     foo = "bar"
@@ -72,6 +72,8 @@ func TestAccOTCVpcV1_update(t *testing.T) {
 						"opentelekomcloud_vpc_v1.vpc_1", "shared", "false"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_vpc_v1.vpc_1", "tags.key", "value_update"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "secondary_cidrs.#", "1"),
 				),
 			},
 		},
@@ -168,6 +170,8 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
   cidr   = "192.168.0.0/16"
   shared = false
 
+  secondary_cidrs = ["172.16.0.0/16"]
+
   tags = {
     foo = "bar"
     key = "value_update"
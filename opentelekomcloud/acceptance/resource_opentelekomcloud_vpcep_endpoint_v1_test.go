@@ -0,0 +1,99 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpcep"
+)
+
+func TestAccVPCEPEndpointV1_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_vpcep_endpoint_v1.endpoint_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCEPEndpointV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEPEndpointV1_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "accepted"),
+					resource.TestCheckResourceAttrSet(resourceName, "port_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_record.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVPCEPEndpointV1ImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCEPEndpointV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.VpcEpV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpcep_endpoint_v1" {
+			continue
+		}
+
+		if _, err := vpcep.GetEndpoint(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("VPC endpoint still exists")
+		}
+	}
+
+	return testAccCheckVPCEPServiceV1Destroy(s)
+}
+
+func testAccVPCEPEndpointV1ImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.ID,
+			rs.Primary.Attributes["dns_record.0.zone_id"], rs.Primary.Attributes["dns_record.0.id"]), nil
+	}
+}
+
+func testAccVPCEPEndpointV1_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%s.internal."
+  email = "email1@example.com"
+  type  = "private"
+
+  router {
+    router_id     = "%s"
+    router_region = "%s"
+  }
+}
+
+resource "opentelekomcloud_vpcep_endpoint_v1" "endpoint_1" {
+  service_id = opentelekomcloud_vpcep_service_v1.service_1.id
+  vpc_id     = "%s"
+  subnet_id  = "%s"
+
+  dns_record {
+    zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+    name    = "service.%s.internal."
+  }
+}
+`, testAccVPCEPServiceV1_update(rName), rName, OS_VPC_ID, OS_REGION_NAME, OS_VPC_ID, OS_NETWORK_ID, rName)
+}
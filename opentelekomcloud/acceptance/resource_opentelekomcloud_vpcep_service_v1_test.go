@@ -0,0 +1,151 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpcep"
+)
+
+func TestAccVPCEPServiceV1_basic(t *testing.T) {
+	var service vpcep.Service
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_vpcep_service_v1.service_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCEPServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEPServiceV1_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEPServiceV1Exists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "server_type", "LB"),
+					resource.TestCheckResourceAttr(resourceName, "approval_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "0"),
+				),
+			},
+			{
+				Config: testAccVPCEPServiceV1_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &service.ID),
+					resource.TestCheckResourceAttr(resourceName, "approval_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "port.0.client_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCEPServiceV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.VpcEpV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpcep_service_v1" {
+			continue
+		}
+
+		if _, err := vpcep.GetService(client, rs.Primary.ID); err == nil {
+			return fmt.Errorf("VPC endpoint service still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVPCEPServiceV1Exists(n string, service *vpcep.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.VpcEpV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+		}
+
+		found, err := vpcep.GetService(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("VPC endpoint service not found")
+		}
+
+		*service = *found
+
+		return nil
+	}
+}
+
+func testAccVPCEPServiceV1_base(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "%s"
+  vip_subnet_id = "%s"
+}
+`, rName, OS_SUBNET_ID)
+}
+
+func testAccVPCEPServiceV1_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpcep_service_v1" "service_1" {
+  name        = "%s"
+  server_type = "LB"
+  port_id     = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.vip_port_id
+  vpc_id      = "%s"
+
+  port {
+    client_port = 80
+    server_port = 80
+  }
+}
+`, testAccVPCEPServiceV1_base(rName), rName, OS_VPC_ID)
+}
+
+func testAccVPCEPServiceV1_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpcep_service_v1" "service_1" {
+  name             = "%s"
+  server_type      = "LB"
+  port_id          = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.vip_port_id
+  vpc_id           = "%s"
+  approval_enabled = false
+  permissions      = ["*"]
+
+  port {
+    client_port = 8080
+    server_port = 80
+  }
+}
+`, testAccVPCEPServiceV1_base(rName), rName, OS_VPC_ID)
+}
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -84,6 +85,19 @@ func TestAccVpnIKEPolicyV2_withNewParams(t *testing.T) {
 	})
 }
 
+func TestAccVpnIKEPolicyV2_unsupportedCombination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIKEPolicyV2_unsupportedCombination,
+				ExpectError: regexp.MustCompile("auth_algorithm `md5` can only be used with ike_version `v1`"),
+			},
+		},
+	})
+}
+
 func testAccCheckIKEPolicyV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
@@ -178,3 +192,10 @@ resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_1" {
   }
 }
 `
+
+const testAccIKEPolicyV2_unsupportedCombination = `
+resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_1" {
+  auth_algorithm = "md5"
+  ike_version    = "v2"
+}
+`
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -73,6 +74,19 @@ func TestAccVpnIPSecPolicyV2_withLifetime(t *testing.T) {
 	})
 }
 
+func TestAccVpnIPSecPolicyV2_unsupportedCombination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIPSecPolicyV2_unsupportedCombination,
+				ExpectError: regexp.MustCompile("encapsulation_mode `transport` is not supported"),
+			},
+		},
+	})
+}
+
 func testAccCheckIPSecPolicyV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
@@ -153,3 +167,13 @@ resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_1" {
 	}
 }
 `
+
+const testAccIPSecPolicyV2_unsupportedCombination = `
+resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_1" {
+	encapsulation_mode = "transport"
+	lifetime {
+		units = "kilobytes"
+		value = 1200
+	}
+}
+`
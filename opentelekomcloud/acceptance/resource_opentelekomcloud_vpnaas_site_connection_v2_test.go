@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -35,12 +36,31 @@ func TestAccVpnSiteConnectionV2_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPtr("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "local_id", &conn.LocalID),
 					resource.TestCheckResourceAttrPtr("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "peer_ep_group_id", &conn.PeerEPGroupID),
 					resource.TestCheckResourceAttrPtr("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "name", &conn.Name),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_vpnaas_site_connection_v2.conn_1", "status"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccVpnSiteConnectionV2_policyCombination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSiteConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				// policies have to exist to be cross-checked during the plan
+				Config: testAccSiteConnectionV2_policies,
+			},
+			{
+				Config:      testAccSiteConnectionV2_policyCombination,
+				ExpectError: regexp.MustCompile(`IPSec policy lifetime \(7200\) has to be less than IKE policy lifetime \(3600\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckSiteConnectionV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
@@ -152,3 +172,45 @@ var testAccSiteConnectionV2_basic = fmt.Sprintf(`
 		depends_on = ["opentelekomcloud_networking_router_interface_v2.router_interface_1"]
 	}
 	`, OS_EXTGW_ID)
+
+var testAccSiteConnectionV2_policies = `
+resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_1" {
+  pfs = "group5"
+  lifetime {
+    units = "seconds"
+    value = 7200
+  }
+}
+
+resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_2" {
+  pfs = "group5"
+  lifetime {
+    units = "seconds"
+    value = 3600
+  }
+}
+`
+
+var testAccSiteConnectionV2_policyCombination = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name             = "my_router"
+  external_gateway = "%s"
+}
+
+resource "opentelekomcloud_vpnaas_service_v2" "service_1" {
+  router_id      = opentelekomcloud_networking_router_v2.router_1.id
+  admin_state_up = "false"
+}
+
+resource "opentelekomcloud_vpnaas_site_connection_v2" "conn_1" {
+  name           = "connection_1"
+  ikepolicy_id   = opentelekomcloud_vpnaas_ike_policy_v2.policy_2.id
+  ipsecpolicy_id = opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1.id
+  vpnservice_id  = opentelekomcloud_vpnaas_service_v2.service_1.id
+  psk            = "secret"
+  peer_address   = "192.168.10.1"
+  peer_cidrs     = ["10.2.0.0/24"]
+}
+`, testAccSiteConnectionV2_policies, OS_EXTGW_ID)
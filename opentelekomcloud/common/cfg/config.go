@@ -729,6 +729,17 @@ func (c *Config) NetworkingV2Client(region string) (*golangsdk.ServiceClient, er
 	})
 }
 
+// NetworkingV3Client returns the client of the VPC v3 API,
+// the endpoint is built from the VPC one, as VPC v3 is not present in the catalog
+func (c *Config) NetworkingV3Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv3/%s/vpc/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) SmnV2Client(projectName ProjectName) (*golangsdk.ServiceClient, error) {
 	newConfig, err := reconfigProjectName(*c, projectName)
 	if err != nil {
@@ -841,6 +852,30 @@ func (c *Config) ElbV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// ElbV3Client returns the client of the dedicated load balancer service,
+// the endpoint is built from the VPC one, as ELB v3 is not present in the catalog
+func (c *Config) ElbV3Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.Endpoint = strings.Replace(client.Endpoint, "vpc", "elb", 1)
+	client.ResourceBase = fmt.Sprintf("%sv3/%s/elb/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
+// VpcEpV1Client returns the client of the VPC endpoint service,
+// the endpoint is built from the VPC one, as VPCEP is not present in the catalog
+func (c *Config) VpcEpV1Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.Endpoint = strings.Replace(client.Endpoint, "vpc", "vpcep", 1)
+	client.ResourceBase = fmt.Sprintf("%sv1/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) RdsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewRDSV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -896,6 +931,17 @@ func (c *Config) DcsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// DcsV2Client returns the client of the DCS v2 API, e.g. used for the instance whitelists,
+// the endpoint is built from the DCS v1 one
+func (c *Config) DcsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DcsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv2/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) RdsTagV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewRdsTagV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
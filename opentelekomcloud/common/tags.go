@@ -1,6 +1,8 @@
 package common
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
@@ -14,7 +16,8 @@ func TagsSchema() *schema.Schema {
 	}
 }
 
-func tagsSchemaComputed() *schema.Schema {
+// TagsSchemaComputed returns the schema to use for tags which can be filled by the API.
+func TagsSchemaComputed() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
 		Optional: true,
@@ -62,6 +65,25 @@ func TagsToMap(tags []tags.ResourceTag) map[string]string {
 	return result
 }
 
+// GetResourceTagsMatch returns the tags of the resource and checks that all expected tags are present.
+// Resources deleted in the meantime don't match.
+func GetResourceTagsMatch(client *golangsdk.ServiceClient, resourceType, id string, expected map[string]interface{}) (map[string]string, bool, error) {
+	resourceTags, err := tags.Get(client, resourceType, id).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("error fetching tags of %s %s: %s", resourceType, id, err)
+	}
+	tagMap := TagsToMap(resourceTags)
+	for k, v := range expected {
+		if value, ok := tagMap[k]; !ok || value != v.(string) {
+			return tagMap, false, nil
+		}
+	}
+	return tagMap, true, nil
+}
+
 // ExpandResourceTags returns the tags for the given map of data.
 func ExpandResourceTags(tagMap map[string]interface{}) []tags.ResourceTag {
 	var tagList []tags.ResourceTag
@@ -39,6 +39,7 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/smn"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vbs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpc"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpcep"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpn"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/waf"
 )
@@ -219,9 +220,12 @@ func Provider() terraform.ResourceProvider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"opentelekomcloud_antiddos_v1":                   antiddos.DataSourceAntiDdosV1(),
+			"opentelekomcloud_cbr_backups_v3":                cbr.DataSourceCBRBackupsV3(),
 			"opentelekomcloud_cce_cluster_v3":                cce.DataSourceCCEClusterV3(),
 			"opentelekomcloud_cce_node_ids_v3":               cce.DataSourceCceNodeIdsV3(),
 			"opentelekomcloud_cce_node_v3":                   cce.DataSourceCceNodesV3(),
+			"opentelekomcloud_ces_metric_data_v1":            ces.DataSourceCesMetricDataV1(),
+			"opentelekomcloud_ces_metrics_v1":                ces.DataSourceCesMetricsV1(),
 			"opentelekomcloud_compute_availability_zones_v2": ecs.DataSourceComputeAvailabilityZonesV2(),
 			"opentelekomcloud_compute_bms_flavors_v2":        bms.DataSourceBMSFlavorV2(),
 			"opentelekomcloud_compute_bms_keypairs_v2":       bms.DataSourceBMSKeyPairV2(),
@@ -250,6 +254,11 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_images_image_v2":               ims.DataSourceImagesImageV2(),
 			"opentelekomcloud_kms_key_v1":                    kms.DataSourceKmsKeyV1(),
 			"opentelekomcloud_kms_data_key_v1":               kms.DataSourceKmsDataKeyV1(),
+			"opentelekomcloud_lb_certificate_v2":             elb.DataSourceCertificateV2(),
+			"opentelekomcloud_lb_listener_v2":                elb.DataSourceListenerV2(),
+			"opentelekomcloud_lb_loadbalancer_v2":            elb.DataSourceLoadBalancerV2(),
+			"opentelekomcloud_lb_pool_v2":                    elb.DataSourcePoolV2(),
+			"opentelekomcloud_nat_gateway_v2":                nat.DataSourceNatGatewayV2(),
 			"opentelekomcloud_networking_network_v2":         vpc.DataSourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_port_v2":            vpc.DataSourceNetworkingPortV2(),
 			"opentelekomcloud_networking_secgroup_v2":        vpc.DataSourceNetworkingSecGroupV2(),
@@ -280,15 +289,21 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_antiddos_v1":                        antiddos.ResourceAntiDdosV1(),
 			"opentelekomcloud_as_configuration_v1":                as.ResourceASConfiguration(),
 			"opentelekomcloud_as_group_v1":                        as.ResourceASGroup(),
+			"opentelekomcloud_as_instance_attach_v1":              as.ResourceASInstanceAttach(),
+			"opentelekomcloud_as_lifecycle_hook_v1":               as.ResourceASLifecycleHook(),
 			"opentelekomcloud_as_policy_v1":                       as.ResourceASPolicy(),
 			"opentelekomcloud_blockstorage_volume_v2":             evs.ResourceBlockStorageVolumeV2(),
+			"opentelekomcloud_cbr_checkpoint_v3":                  cbr.ResourceCBRCheckpointV3(),
 			"opentelekomcloud_cbr_policy_v3":                      cbr.ResourceCBRPolicyV3(),
+			"opentelekomcloud_cbr_restore_v3":                     cbr.ResourceCBRRestoreV3(),
 			"opentelekomcloud_cbr_vault_v3":                       cbr.ResourceCBRVaultV3(),
 			"opentelekomcloud_cce_addon_v3":                       cce.ResourceCCEAddonV3(),
 			"opentelekomcloud_cce_cluster_v3":                     cce.ResourceCCEClusterV3(),
 			"opentelekomcloud_cce_node_v3":                        cce.ResourceCCENodeV3(),
 			"opentelekomcloud_cce_node_pool_v3":                   cce.ResourceCCENodePoolV3(),
 			"opentelekomcloud_ces_alarmrule":                      ces.ResourceAlarmRule(),
+			"opentelekomcloud_ces_alarm_template_v1":              ces.ResourceCesAlarmTemplateV1(),
+			"opentelekomcloud_ces_resource_group_v1":              ces.ResourceCesResourceGroupV1(),
 			"opentelekomcloud_compute_bms_server_v2":              bms.ResourceComputeBMSInstanceV2(),
 			"opentelekomcloud_compute_bms_tags_v2":                bms.ResourceBMSTagsV2(),
 			"opentelekomcloud_compute_secgroup_v2":                ecs.ResourceComputeSecGroupV2(),
@@ -303,6 +318,7 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_cts_tracker_v1":                     cts.ResourceCTSTrackerV1(),
 			"opentelekomcloud_css_cluster_v1":                     css.ResourceCssClusterV1(),
 			"opentelekomcloud_dcs_instance_v1":                    dcs.ResourceDcsInstanceV1(),
+			"opentelekomcloud_dcs_whitelist_v1":                   dcs.ResourceDcsWhitelistV1(),
 			"opentelekomcloud_dds_instance_v3":                    dds.ResourceDdsInstanceV3(),
 			"opentelekomcloud_deh_host_v1":                        deh.ResourceDeHHostV1(),
 			"opentelekomcloud_dns_ptrrecord_v2":                   dns.ResourceDNSPtrRecordV2(),
@@ -311,6 +327,7 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_dms_group_v1":                       dms.ResourceDmsGroupsV1(),
 			"opentelekomcloud_dms_instance_v1":                    dms.ResourceDmsInstancesV1(),
 			"opentelekomcloud_dms_queue_v1":                       dms.ResourceDmsQueuesV1(),
+			"opentelekomcloud_dms_topic_v1":                       dms.ResourceDmsTopicsV1(),
 			"opentelekomcloud_ecs_instance_v1":                    ecs.ResourceEcsInstanceV1(),
 			"opentelekomcloud_elb_backend":                        elb.ResourceBackend(),
 			"opentelekomcloud_elb_health":                         elb.ResourceHealth(),
@@ -338,9 +355,17 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_lb_loadbalancer_v2":                 elb.ResourceLoadBalancerV2(),
 			"opentelekomcloud_lb_listener_v2":                     elb.ResourceListenerV2(),
 			"opentelekomcloud_lb_member_v2":                       elb.ResourceMemberV2(),
+			"opentelekomcloud_lb_members_v2":                      elb.ResourceMembersV2(),
 			"opentelekomcloud_lb_monitor_v2":                      elb.ResourceMonitorV2(),
 			"opentelekomcloud_lb_pool_v2":                         elb.ResourcePoolV2(),
 			"opentelekomcloud_lb_whitelist_v2":                    elb.ResourceWhitelistV2(),
+			"opentelekomcloud_lb_certificate_v3":                  elb.ResourceCertificateV3(),
+			"opentelekomcloud_lb_ipgroup_v3":                      elb.ResourceIPGroupV3(),
+			"opentelekomcloud_lb_listener_v3":                     elb.ResourceListenerV3(),
+			"opentelekomcloud_lb_loadbalancer_v3":                 elb.ResourceLoadBalancerV3(),
+			"opentelekomcloud_lb_member_v3":                       elb.ResourceMemberV3(),
+			"opentelekomcloud_lb_monitor_v3":                      elb.ResourceMonitorV3(),
+			"opentelekomcloud_lb_pool_v3":                         elb.ResourcePoolV3(),
 			"opentelekomcloud_logtank_group_v2":                   lts.ResourceLTSGroupV2(),
 			"opentelekomcloud_logtank_topic_v2":                   lts.ResourceLTSTopicV2(),
 			"opentelekomcloud_mrs_cluster_v1":                     mrs.ResourceMRSClusterV1(),
@@ -376,6 +401,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_sfs_turbo_share_v1":                 sfs.ResourceSFSTurboShareV1(),
 			"opentelekomcloud_smn_topic_v2":                       smn.ResourceTopic(),
 			"opentelekomcloud_smn_subscription_v2":                smn.ResourceSubscription(),
+			"opentelekomcloud_vpc_bandwidth_v2":                   vpc.ResourceBandWidthV2(),
+			"opentelekomcloud_vpc_bandwidth_associate_v2":         vpc.ResourceBandWidthAssociateV2(),
 			"opentelekomcloud_vpc_eip_v1":                         vpc.ResourceVpcEIPV1(),
 			"opentelekomcloud_vpc_v1":                             vpc.ResourceVirtualPrivateCloudV1(),
 			"opentelekomcloud_vpc_peering_connection_v2":          vpc.ResourceVpcPeeringConnectionV2(),
@@ -387,6 +414,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_vbs_backup_v2":                      vbs.ResourceVBSBackupV2(),
 			"opentelekomcloud_vbs_backup_share_v2":                vbs.ResourceVBSBackupShareV2(),
 			"opentelekomcloud_sdrs_protectiongroup_v1":            sdrs.ResourceSdrsProtectiongroupV1(),
+			"opentelekomcloud_vpcep_service_v1":                   vpcep.ResourceVPCEPServiceV1(),
+			"opentelekomcloud_vpcep_endpoint_v1":                  vpcep.ResourceVPCEPEndpointV1(),
 			"opentelekomcloud_vpnaas_ipsec_policy_v2":             vpn.ResourceVpnIPSecPolicyV2(),
 			"opentelekomcloud_vpnaas_service_v2":                  vpn.ResourceVpnServiceV2(),
 			"opentelekomcloud_vpnaas_ike_policy_v2":               vpn.ResourceVpnIKEPolicyV2(),
@@ -28,6 +28,7 @@ func ResourceASGroup() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -99,6 +100,7 @@ func ResourceASGroup() *schema.Resource {
 			"available_zones": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"networks": {
@@ -178,6 +180,12 @@ func ResourceASGroup() *schema.Resource {
 				Default:     "no",
 				Description: "Whether to delete instances when they are removed from the AS group.",
 			},
+			"rolling_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to replace instances one by one when the scaling configuration changes.",
+			},
 			"instances": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -454,6 +462,10 @@ func resourceASGroupRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("instance_terminate_policy", asGroup.InstanceTerminatePolicy),
 		d.Set("scaling_configuration_id", asGroup.ConfigurationID),
 		d.Set("delete_publicip", asGroup.DeletePublicip),
+		d.Set("vpc_id", asGroup.VpcID),
+		d.Set("available_zones", asGroup.AvailableZones),
+		d.Set("networks", flattenASGroupNetworks(asGroup.Networks)),
+		d.Set("security_groups", flattenASGroupSecurityGroups(asGroup.SecurityGroups)),
 		d.Set("region", config.GetRegion(d)),
 	)
 	if len(asGroup.Notifications) >= 1 {
@@ -501,32 +513,24 @@ func resourceASGroupRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceASGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*cfg.Config)
-	client, err := config.AutoscalingV1Client(config.GetRegion(d))
-	if err != nil {
-		return fmt.Errorf("error creating OpenTelekomCloud autoscaling client: %s", err)
+func flattenASGroupNetworks(networks []groups.Network) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(networks))
+	for i, network := range networks {
+		result[i] = map[string]interface{}{"id": network.ID}
 	}
-	d.Partial(true)
-
-	if d.HasChange("min_instance_number") || d.HasChange("max_instance_number") || d.HasChange("desire_instance_number") || d.HasChange("lbaas_listeners") {
-		minNum := d.Get("min_instance_number").(int)
-		maxNum := d.Get("max_instance_number").(int)
-		desireNum := d.Get("desire_instance_number").(int)
-		log.Printf("[DEBUG] Min instance number is: %#v", minNum)
-		log.Printf("[DEBUG] Max instance number is: %#v", maxNum)
-		log.Printf("[DEBUG] Desire instance number is: %#v", desireNum)
-		if desireNum < minNum || desireNum > maxNum {
-			return fmt.Errorf("invalid parameters: it should be min_instance_number<=desire_instance_number<=max_instance_number")
-		}
+	return result
+}
 
+func flattenASGroupSecurityGroups(secGroups []groups.SecurityGroup) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(secGroups))
+	for i, secGroup := range secGroups {
+		result[i] = map[string]interface{}{"id": secGroup.ID}
 	}
+	return result
+}
 
-	networks := getAllNetworks(d)
-	secGroups := getAllSecurityGroups(d)
-
-	asgLBaaSListeners := getAllLBaaSListeners(d)
-	updateOpts := groups.UpdateOpts{
+func buildASGroupUpdateOpts(d *schema.ResourceData) GroupUpdateOpts {
+	return GroupUpdateOpts{
 		Name:                      d.Get("scaling_group_name").(string),
 		ConfigurationID:           d.Get("scaling_configuration_id").(string),
 		DesireInstanceNumber:      d.Get("desire_instance_number").(int),
@@ -534,10 +538,10 @@ func resourceASGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		MaxInstanceNumber:         d.Get("max_instance_number").(int),
 		CoolDownTime:              d.Get("cool_down_time").(int),
 		LBListenerID:              d.Get("lb_listener_id").(string),
-		LBaaSListeners:            asgLBaaSListeners,
+		LBaaSListeners:            getAllLBaaSListeners(d),
 		AvailableZones:            common.GetAllAvailableZones(d),
-		Networks:                  networks,
-		SecurityGroup:             secGroups,
+		Networks:                  getAllNetworks(d),
+		SecurityGroup:             getAllSecurityGroups(d),
 		HealthPeriodicAuditMethod: d.Get("health_periodic_audit_method").(string),
 		HealthPeriodicAuditTime:   d.Get("health_periodic_audit_time").(int),
 		HealthPeriodicAuditGrace:  d.Get("health_periodic_audit_grace_period").(int),
@@ -545,11 +549,41 @@ func resourceASGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		Notifications:             getAllNotifications(d),
 		IsDeletePublicip:          d.Get("delete_publicip").(bool),
 	}
+}
+
+func resourceASGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+	d.Partial(true)
+
+	if d.HasChanges("min_instance_number", "max_instance_number", "desire_instance_number", "lbaas_listeners") {
+		minNum := d.Get("min_instance_number").(int)
+		maxNum := d.Get("max_instance_number").(int)
+		desireNum := d.Get("desire_instance_number").(int)
+		log.Printf("[DEBUG] Min instance number is: %#v", minNum)
+		log.Printf("[DEBUG] Max instance number is: %#v", maxNum)
+		log.Printf("[DEBUG] Desire instance number is: %#v", desireNum)
+		if desireNum < minNum || desireNum > maxNum {
+			return fmt.Errorf("invalid parameters: it should be min_instance_number<=desire_instance_number<=max_instance_number")
+		}
+	}
+
+	updateOpts := buildASGroupUpdateOpts(d)
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
 	asGroupID, err := groups.Update(client, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("error updating ASGroup %q: %s", asGroupID, err)
 	}
 
+	if d.HasChange("scaling_configuration_id") && d.Get("rolling_update").(bool) {
+		if err := rollASGroupInstances(d, client); err != nil {
+			return err
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		if err := common.UpdateResourceTags(client, d, "scaling_group_tag", d.Id()); err != nil {
@@ -561,6 +595,69 @@ func resourceASGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceASGroupRead(d, meta)
 }
 
+// rollASGroupInstances replaces instances created from the old scaling configuration one by one:
+// the desired number is increased by one to launch an instance with the new configuration,
+// then an outdated instance is removed, which brings the desired number back.
+func rollASGroupInstances(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	configurationID := d.Get("scaling_configuration_id").(string)
+
+	var opts instances.ListOptsBuilder
+	instanceList, err := getInstancesInGroup(client, d.Id(), opts)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instanceList {
+		if instance.ConfigurationID == configurationID || instance.ID == "" {
+			continue
+		}
+
+		asGroup, err := groups.Get(client, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving ASGroup %q: %s", d.Id(), err)
+		}
+		surgeNum := asGroup.DesireInstanceNumber + 1
+		if surgeNum > asGroup.MaxInstanceNumber {
+			return fmt.Errorf("rolling update of ASGroup %q requires max_instance_number greater than desire_instance_number", d.Id())
+		}
+
+		log.Printf("[DEBUG] Replacing instance %q of ASGroup %q", instance.ID, d.Id())
+		updateOpts := buildASGroupUpdateOpts(d)
+		updateOpts.DesireInstanceNumber = surgeNum
+		if _, err := groups.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("error increasing desire_instance_number of ASGroup %q: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"PENDING"},
+			Target:  []string{"INSERVICE"},
+			Refresh: refreshInstancesLifeStates(client, d.Id(), surgeNum, true),
+			Timeout: d.Timeout(schema.TimeoutUpdate),
+			Delay:   10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for instances in the ASGroup %q to become inservice: %s", d.Id(), err)
+		}
+
+		deleteInstances := d.Get("delete_instances").(string)
+		if err := instances.BatchDelete(client, d.Id(), []string{instance.ID}, deleteInstances).ExtractErr(); err != nil {
+			return fmt.Errorf("error removing instance %q from ASGroup %q: %s", instance.ID, d.Id(), err)
+		}
+
+		stateConf = &resource.StateChangeConf{
+			Pending: []string{"REMOVING"},
+			Target:  []string{""},
+			Refresh: refreshInstancesLifeStates(client, d.Id(), 0, false),
+			Timeout: d.Timeout(schema.TimeoutUpdate),
+			Delay:   10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error removing instance %q from ASGroup %q: %s", instance.ID, d.Id(), err)
+		}
+	}
+	return nil
+}
+
 func resourceASGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.AutoscalingV1Client(config.GetRegion(d))
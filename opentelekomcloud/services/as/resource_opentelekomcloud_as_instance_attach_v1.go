@@ -0,0 +1,235 @@
+package as
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceASInstanceAttach() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceASInstanceAttachCreate,
+		Read:   resourceASInstanceAttachRead,
+		Update: resourceASInstanceAttachUpdate,
+		Delete: resourceASInstanceAttachDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceASInstanceAttachImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_instance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func setASInstanceProtection(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	action := "UNPROTECT"
+	if d.Get("protected").(bool) {
+		action = "PROTECT"
+	}
+	err = BatchInstancesAction(client, d.Get("scaling_group_id").(string), []string{d.Id()}, action).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("error setting scale-in protection of instance %q: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// refreshInstanceLifeState returns the lifecycle state of the single instance of the group,
+// `DETACHED` is returned when the instance is not in the group
+func refreshInstanceLifeState(client *golangsdk.ServiceClient, groupID, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var opts instances.ListOptsBuilder
+		instanceList, err := getInstancesInGroup(client, groupID, opts)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		for _, instance := range instanceList {
+			if instance.ID == instanceID {
+				log.Printf("[DEBUG] Lifecycle status of instance %q in group %q: %s", instanceID, groupID, instance.LifeCycleStatus)
+				return instance, instance.LifeCycleStatus, nil
+			}
+		}
+		return instanceID, "DETACHED", nil
+	}
+}
+
+func resourceASInstanceAttachCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	instanceID := d.Get("instance_id").(string)
+
+	if err := instances.BatchAdd(client, groupID, []string{instanceID}).ExtractErr(); err != nil {
+		return fmt.Errorf("error adding instance %q to ASGroup %q: %s", instanceID, groupID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DETACHED", "PENDING", "PENDING_WAIT"},
+		Target:  []string{"INSERVICE"},
+		Refresh: refreshInstanceLifeState(client, groupID, instanceID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for instance %q in the ASGroup %q to become inservice: %s", instanceID, groupID, err)
+	}
+
+	d.SetId(instanceID)
+
+	if d.Get("protected").(bool) {
+		if err := setASInstanceProtection(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceASInstanceAttachRead(d, meta)
+}
+
+func resourceASInstanceAttachRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	var opts instances.ListOptsBuilder
+	instanceList, err := getInstancesInGroup(client, d.Get("scaling_group_id").(string), opts)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instanceList {
+		if instance.ID != d.Id() {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("instance_id", instance.ID),
+			d.Set("status", instance.LifeCycleStatus),
+			d.Set("health_status", instance.HealthStatus),
+			d.Set("region", config.GetRegion(d)),
+		)
+		return mErr.ErrorOrNil()
+	}
+
+	log.Printf("[WARN] Instance %q not found in ASGroup, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceASInstanceAttachUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("protected") {
+		if err := setASInstanceProtection(d, meta); err != nil {
+			return err
+		}
+	}
+	return resourceASInstanceAttachRead(d, meta)
+}
+
+func resourceASInstanceAttachDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	if d.Get("protected").(bool) {
+		if err := BatchInstancesAction(client, groupID, []string{d.Id()}, "UNPROTECT").ExtractErr(); err != nil {
+			return fmt.Errorf("error removing scale-in protection of instance %q: %s", d.Id(), err)
+		}
+	}
+
+	deleteInstance := "no"
+	if d.Get("delete_instance").(bool) {
+		deleteInstance = "yes"
+	}
+	if err := instances.BatchDelete(client, groupID, []string{d.Id()}, deleteInstance).ExtractErr(); err != nil {
+		return fmt.Errorf("error removing instance %q from ASGroup %q: %s", d.Id(), groupID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"INSERVICE", "REMOVING", "REMOVING_WAIT"},
+		Target:  []string{"DETACHED"},
+		Refresh: refreshInstanceLifeState(client, groupID, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error removing instance %q from ASGroup %q: %s", d.Id(), groupID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceASInstanceAttachImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("invalid format specified for AutoScaling instance attach. Format must be <scaling group id>/<instance id>")
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("scaling_group_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
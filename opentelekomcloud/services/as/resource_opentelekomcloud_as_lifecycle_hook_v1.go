@@ -0,0 +1,210 @@
+package as
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceASLifecycleHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceASLifecycleHookCreate,
+		Read:   resourceASLifecycleHookRead,
+		Update: resourceASLifecycleHookUpdate,
+		Delete: resourceASLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceASLifecycleHookImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+					validation.StringMatch(regexp.MustCompile(`^[\w-]+$`),
+						"only letters, digits, hyphens (-), and underscores (_) are allowed"),
+				),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ADD", "REMOVE",
+				}, false),
+			},
+			"default_result": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ABANDON",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ABANDON", "CONTINUE",
+				}, false),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(300, 86400),
+			},
+			"notification_topic_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"notification_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notification_topic_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// lifecycle hook types are named after the scaling action they are attached to
+var lifecycleHookTypes = map[string]string{
+	"ADD":    "INSTANCE_LAUNCHING",
+	"REMOVE": "INSTANCE_TERMINATING",
+}
+
+func resourceASLifecycleHookCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	createOpts := LifecycleHookOpts{
+		Name:                 d.Get("name").(string),
+		Type:                 lifecycleHookTypes[d.Get("type").(string)],
+		DefaultResult:        d.Get("default_result").(string),
+		DefaultTimeout:       d.Get("timeout").(int),
+		NotificationTopicURN: d.Get("notification_topic_urn").(string),
+		NotificationMetadata: d.Get("notification_message").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	hook, err := CreateLifecycleHook(client, groupID, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating AutoScaling lifecycle hook: %s", err)
+	}
+
+	d.SetId(hook.Name)
+
+	return resourceASLifecycleHookRead(d, meta)
+}
+
+func resourceASLifecycleHookRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	hook, err := GetLifecycleHook(client, d.Get("scaling_group_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error retrieving AutoScaling lifecycle hook")
+	}
+
+	hookType := hook.Type
+	for k, v := range lifecycleHookTypes {
+		if v == hook.Type {
+			hookType = k
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", hook.Name),
+		d.Set("type", hookType),
+		d.Set("default_result", hook.DefaultResult),
+		d.Set("timeout", hook.DefaultTimeout),
+		d.Set("notification_topic_urn", hook.NotificationTopicURN),
+		d.Set("notification_topic_name", hook.NotificationTopicName),
+		d.Set("notification_message", hook.NotificationMetadata),
+		d.Set("create_time", hook.CreateTime),
+		d.Set("region", config.GetRegion(d)),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceASLifecycleHookUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	updateOpts := LifecycleHookOpts{
+		Type:                 lifecycleHookTypes[d.Get("type").(string)],
+		DefaultResult:        d.Get("default_result").(string),
+		DefaultTimeout:       d.Get("timeout").(int),
+		NotificationTopicURN: d.Get("notification_topic_urn").(string),
+		NotificationMetadata: d.Get("notification_message").(string),
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = UpdateLifecycleHook(client, d.Get("scaling_group_id").(string), d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("error updating AutoScaling lifecycle hook %q: %s", d.Id(), err)
+	}
+
+	return resourceASLifecycleHookRead(d, meta)
+}
+
+func resourceASLifecycleHookDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud AutoScaling client: %s", err)
+	}
+
+	err = DeleteLifecycleHook(client, d.Get("scaling_group_id").(string), d.Id()).ExtractErr()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting AutoScaling lifecycle hook")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceASLifecycleHookImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("invalid format specified for AutoScaling lifecycle hook. Format must be <scaling group id>/<hook name>")
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("scaling_group_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -7,8 +7,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cloudeyeservice/alarmrule"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -21,6 +26,8 @@ func ResourceASPolicy() *schema.Resource {
 		Update: resourceASPolicyUpdate,
 		Delete: resourceASPolicyDelete,
 
+		CustomizeDiff: customdiff.ForceNewIfChange("scaling_policy_type", isTargetTrackingSwitched),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -48,8 +55,75 @@ func ResourceASPolicy() *schema.Resource {
 			"alarm_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: false,
 			},
+			"target_tracking": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"alarm_id", "scheduled_policy", "scaling_policy_action"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "SYS.AS",
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"tolerance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"filter": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "average",
+							ValidateFunc: validation.StringInSlice([]string{"max", "min", "average", "sum", "variance"}, false),
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"scale_out_instance_number": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"scale_in_instance_number": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+			"scale_in_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale_in_alarm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"scheduled_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -138,6 +212,16 @@ func validateParameters(d *schema.ResourceData) error {
 			return fmt.Errorf("Parameter alarm_id should be set if policy type is ALARM.")
 		}
 	}
+	if policyType == targetTrackingType {
+		targetTracking := d.Get("target_tracking").([]interface{})
+		if len(targetTracking) == 0 {
+			return fmt.Errorf("Parameter target_tracking should be set if policy type is %s.", targetTrackingType)
+		}
+		targetTrackingMap := targetTracking[0].(map[string]interface{})
+		if targetTrackingMap["tolerance"].(int) > targetTrackingMap["target_value"].(int) {
+			return fmt.Errorf("Parameter tolerance can't be greater than target_value.")
+		}
+	}
 	if policyType == "SCHEDULED" || policyType == "RECURRENCE" {
 		if len(scheduledPolicy) == 0 {
 			return fmt.Errorf("Parameter scheduled_policy should be set if policy type is RECURRENCE or SCHEDULED.")
@@ -193,6 +277,9 @@ func resourceASPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error creating ASPolicy: %s", err)
 	}
+	if d.Get("scaling_policy_type").(string) == targetTrackingType {
+		return resourceASPolicyTargetTrackingCreate(d, meta)
+	}
 	createOpts := policies.CreateOpts{
 		Name:         d.Get("scaling_policy_name").(string),
 		ID:           d.Get("scaling_group_id").(string),
@@ -236,6 +323,9 @@ func resourceASPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Retrieved ASPolicy %q: %+v", d.Id(), asPolicy)
+	if d.Get("scaling_policy_type").(string) == targetTrackingType {
+		return resourceASPolicyTargetTrackingRead(d, meta, asPolicy)
+	}
 	d.Set("scaling_policy_name", asPolicy.Name)
 	d.Set("scaling_policy_type", asPolicy.Type)
 	d.Set("alarm_id", asPolicy.AlarmID)
@@ -276,6 +366,9 @@ func resourceASPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error updating ASPolicy: %s", err)
 	}
+	if d.Get("scaling_policy_type").(string) == targetTrackingType {
+		return resourceASPolicyTargetTrackingUpdate(d, meta)
+	}
 	updateOpts := policies.UpdateOpts{
 		Name:         d.Get("scaling_policy_name").(string),
 		Type:         d.Get("scaling_policy_type").(string),
@@ -309,6 +402,9 @@ func resourceASPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error creating OpenTelekomCloud autoscaling client: %s", err)
 	}
+	if d.Get("scaling_policy_type").(string) == targetTrackingType {
+		return resourceASPolicyTargetTrackingDelete(d, meta)
+	}
 	log.Printf("[DEBUG] Begin to delete AS policy %q", d.Id())
 	if delErr := policies.Delete(asClient, d.Id()).ExtractErr(); delErr != nil {
 		return fmt.Errorf("Error deleting AS policy: %s", delErr)
@@ -343,7 +439,7 @@ func resourceASPolicyValidateActionOperation(v interface{}, k string) (ws []stri
 	return
 }
 
-var PolicyTypes = [3]string{"ALARM", "SCHEDULED", "RECURRENCE"}
+var PolicyTypes = [4]string{"ALARM", "SCHEDULED", "RECURRENCE", targetTrackingType}
 
 func resourceASPolicyValidatePolicyType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
@@ -366,3 +462,301 @@ func resourceASPolicyValidateName(v interface{}, k string) (ws []string, errors
 	}
 	return
 }
+
+const targetTrackingType = "TARGET_TRACKING"
+
+// targetTrackingAlarmKeys are the target_tracking fields which define the CES alarm rules
+var targetTrackingAlarmKeys = []string{"namespace", "metric_name", "target_value", "tolerance", "filter", "period", "count"}
+
+func isTargetTrackingSwitched(old, new, _ interface{}) bool {
+	return (old.(string) == targetTrackingType) != (new.(string) == targetTrackingType)
+}
+
+func getTargetTracking(d *schema.ResourceData) map[string]interface{} {
+	return d.Get("target_tracking").([]interface{})[0].(map[string]interface{})
+}
+
+// createASPolicyAlarm creates the CES alarm rule on the AS group metric which triggers the AS policy
+func createASPolicyAlarm(client *golangsdk.ServiceClient, d *schema.ResourceData, suffix, operator string, value int) (string, error) {
+	targetTracking := getTargetTracking(d)
+	alarmName := strings.ReplaceAll(d.Get("scaling_policy_name").(string), "-", "_")
+	createOpts := alarmrule.CreateOpts{
+		AlarmName: fmt.Sprintf("%s_%s", alarmName, suffix),
+		Metric: alarmrule.MetricOpts{
+			Namespace:  targetTracking["namespace"].(string),
+			MetricName: targetTracking["metric_name"].(string),
+			Dimensions: []alarmrule.DimensionOpts{
+				{
+					Name:  "AutoScalingGroup",
+					Value: d.Get("scaling_group_id").(string),
+				},
+			},
+		},
+		Condition: alarmrule.ConditionOpts{
+			Period:             targetTracking["period"].(int),
+			Filter:             targetTracking["filter"].(string),
+			ComparisonOperator: operator,
+			Value:              value,
+			Count:              targetTracking["count"].(int),
+		},
+		AlarmActions: []alarmrule.ActionOpts{
+			{
+				Type:             "autoscaling",
+				NotificationList: []string{},
+			},
+		},
+		AlarmEnabled:       true,
+		AlarmActionEnabled: true,
+	}
+	log.Printf("[DEBUG] Create AS policy alarm rule Options: %#v", createOpts)
+	alarm, err := alarmrule.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Error creating CES alarm rule for ASPolicy: %s", err)
+	}
+	return alarm.AlarmID, nil
+}
+
+func createASPolicyAlarms(client *golangsdk.ServiceClient, d *schema.ResourceData) (string, string, error) {
+	targetTracking := getTargetTracking(d)
+	targetValue := targetTracking["target_value"].(int)
+
+	scaleOutAlarmID, err := createASPolicyAlarm(client, d, "scale_out", ">", targetValue)
+	if err != nil {
+		return "", "", err
+	}
+	scaleInAlarmID, err := createASPolicyAlarm(client, d, "scale_in", "<", targetValue-targetTracking["tolerance"].(int))
+	if err != nil {
+		_ = deleteASPolicyAlarm(client, scaleOutAlarmID)
+		return "", "", err
+	}
+	return scaleOutAlarmID, scaleInAlarmID, nil
+}
+
+func deleteASPolicyAlarm(client *golangsdk.ServiceClient, alarmID string) error {
+	if alarmID == "" {
+		return nil
+	}
+	if err := alarmrule.Delete(client, alarmID).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CES alarm rule %q: %s", alarmID, err)
+	}
+	return nil
+}
+
+func getTargetTrackingPolicyOpts(d *schema.ResourceData, alarmID, operation string) policies.UpdateOpts {
+	instanceNumber := getTargetTracking(d)["scale_out_instance_number"].(int)
+	if operation == "REMOVE" {
+		instanceNumber = getTargetTracking(d)["scale_in_instance_number"].(int)
+	}
+	return policies.UpdateOpts{
+		Name:         d.Get("scaling_policy_name").(string),
+		Type:         "ALARM",
+		AlarmID:      alarmID,
+		CoolDownTime: d.Get("cool_down_time").(int),
+		Action: policies.ActionOpts{
+			Operation:   operation,
+			InstanceNum: instanceNumber,
+		},
+	}
+}
+
+// resourceASPolicyTargetTrackingCreate creates a pair of CES alarm rules and a pair of AS policies
+// scaling the group out and in around the target value. The scale-out policy ID is used as the resource ID.
+func resourceASPolicyTargetTrackingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	asClient, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+	cesClient, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	scaleOutAlarmID, scaleInAlarmID, err := createASPolicyAlarms(cesClient, d)
+	if err != nil {
+		return err
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	policyIDs := make([]string, 0, 2)
+	for _, target := range []struct {
+		alarmID   string
+		operation string
+		suffix    string
+	}{
+		{alarmID: scaleOutAlarmID, operation: "ADD"},
+		{alarmID: scaleInAlarmID, operation: "REMOVE", suffix: "-scale-in"},
+	} {
+		opts := getTargetTrackingPolicyOpts(d, target.alarmID, target.operation)
+		createOpts := policies.CreateOpts{
+			Name:         opts.Name + target.suffix,
+			ID:           groupID,
+			Type:         opts.Type,
+			AlarmID:      opts.AlarmID,
+			Action:       opts.Action,
+			CoolDownTime: opts.CoolDownTime,
+		}
+		log.Printf("[DEBUG] Create AS policy Options: %#v", createOpts)
+		policyID, err := policies.Create(asClient, createOpts).Extract()
+		if err != nil {
+			for _, id := range policyIDs {
+				_ = policies.Delete(asClient, id).ExtractErr()
+			}
+			_ = deleteASPolicyAlarm(cesClient, scaleOutAlarmID)
+			_ = deleteASPolicyAlarm(cesClient, scaleInAlarmID)
+			return fmt.Errorf("Error creating ASPolicy: %s", err)
+		}
+		policyIDs = append(policyIDs, policyID)
+	}
+
+	d.SetId(policyIDs[0])
+	log.Printf("[DEBUG] Create AS target tracking Policy %q Success!", d.Id())
+
+	mErr := multierror.Append(nil,
+		d.Set("scale_in_policy_id", policyIDs[1]),
+		d.Set("scale_in_alarm_id", scaleInAlarmID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	return resourceASPolicyRead(d, meta)
+}
+
+func resourceASPolicyTargetTrackingRead(d *schema.ResourceData, meta interface{}, scaleOutPolicy policies.Policy) error {
+	config := meta.(*cfg.Config)
+	asClient, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+	cesClient, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	scaleInPolicy, err := policies.Get(asClient, d.Get("scale_in_policy_id").(string)).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving scale-in ASPolicy: %s", err)
+	}
+	scaleOutAlarm, err := alarmrule.Get(cesClient, scaleOutPolicy.AlarmID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving scale-out CES alarm rule: %s", err)
+	}
+	scaleInAlarm, err := alarmrule.Get(cesClient, scaleInPolicy.AlarmID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving scale-in CES alarm rule: %s", err)
+	}
+
+	targetTracking := []map[string]interface{}{
+		{
+			"namespace":                 scaleOutAlarm.Metric.Namespace,
+			"metric_name":               scaleOutAlarm.Metric.MetricName,
+			"target_value":              scaleOutAlarm.Condition.Value,
+			"tolerance":                 scaleOutAlarm.Condition.Value - scaleInAlarm.Condition.Value,
+			"filter":                    scaleOutAlarm.Condition.Filter,
+			"period":                    scaleOutAlarm.Condition.Period,
+			"count":                     scaleOutAlarm.Condition.Count,
+			"scale_out_instance_number": scaleOutPolicy.Action.InstanceNum,
+			"scale_in_instance_number":  scaleInPolicy.Action.InstanceNum,
+		},
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("scaling_policy_name", scaleOutPolicy.Name),
+		d.Set("alarm_id", scaleOutPolicy.AlarmID),
+		d.Set("cool_down_time", scaleOutPolicy.CoolDownTime),
+		d.Set("scale_in_alarm_id", scaleInPolicy.AlarmID),
+		d.Set("target_tracking", targetTracking),
+		d.Set("region", config.GetRegion(d)),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceASPolicyTargetTrackingUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	asClient, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+	cesClient, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	scaleOutAlarmID := d.Get("alarm_id").(string)
+	scaleInAlarmID := d.Get("scale_in_alarm_id").(string)
+	var oldAlarmIDs []string
+
+	// CES alarm rule conditions can't be changed in place, new alarm rules replace the old ones
+	alarmChanged := false
+	for _, key := range targetTrackingAlarmKeys {
+		if d.HasChange(fmt.Sprintf("target_tracking.0.%s", key)) {
+			alarmChanged = true
+			break
+		}
+	}
+	if alarmChanged {
+		oldAlarmIDs = []string{scaleOutAlarmID, scaleInAlarmID}
+		scaleOutAlarmID, scaleInAlarmID, err = createASPolicyAlarms(cesClient, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	scaleOutOpts := getTargetTrackingPolicyOpts(d, scaleOutAlarmID, "ADD")
+	log.Printf("[DEBUG] Update AS policy Options: %#v", scaleOutOpts)
+	if _, err := policies.Update(asClient, d.Id(), scaleOutOpts).Extract(); err != nil {
+		return fmt.Errorf("Error updating ASPolicy %q: %s", d.Id(), err)
+	}
+
+	scaleInPolicyID := d.Get("scale_in_policy_id").(string)
+	scaleInOpts := getTargetTrackingPolicyOpts(d, scaleInAlarmID, "REMOVE")
+	scaleInOpts.Name += "-scale-in"
+	log.Printf("[DEBUG] Update AS policy Options: %#v", scaleInOpts)
+	if _, err := policies.Update(asClient, scaleInPolicyID, scaleInOpts).Extract(); err != nil {
+		return fmt.Errorf("Error updating ASPolicy %q: %s", scaleInPolicyID, err)
+	}
+
+	for _, alarmID := range oldAlarmIDs {
+		if err := deleteASPolicyAlarm(cesClient, alarmID); err != nil {
+			return err
+		}
+	}
+
+	return resourceASPolicyRead(d, meta)
+}
+
+func resourceASPolicyTargetTrackingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	asClient, err := config.AutoscalingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud autoscaling client: %s", err)
+	}
+	cesClient, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	for _, policyID := range []string{d.Id(), d.Get("scale_in_policy_id").(string)} {
+		if policyID == "" {
+			continue
+		}
+		log.Printf("[DEBUG] Begin to delete AS policy %q", policyID)
+		if err := policies.Delete(asClient, policyID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("Error deleting AS policy: %s", err)
+			}
+		}
+	}
+
+	for _, alarmID := range []string{d.Get("alarm_id").(string), d.Get("scale_in_alarm_id").(string)} {
+		if err := deleteASPolicyAlarm(cesClient, alarmID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
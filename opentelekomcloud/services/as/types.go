@@ -0,0 +1,123 @@
+package as
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/groups"
+)
+
+// LifecycleHook represents the AS group lifecycle hook.
+type LifecycleHook struct {
+	Name                  string `json:"lifecycle_hook_name"`
+	Type                  string `json:"lifecycle_hook_type"`
+	DefaultResult         string `json:"default_result"`
+	DefaultTimeout        int    `json:"default_timeout"`
+	NotificationTopicURN  string `json:"notification_topic_urn"`
+	NotificationTopicName string `json:"notification_topic_name"`
+	NotificationMetadata  string `json:"notification_metadata"`
+	CreateTime            string `json:"create_time"`
+}
+
+// LifecycleHookOpts represents the attributes used when creating or updating the AS group lifecycle hook.
+type LifecycleHookOpts struct {
+	Name                 string `json:"lifecycle_hook_name,omitempty"`
+	Type                 string `json:"lifecycle_hook_type,omitempty"`
+	DefaultResult        string `json:"default_result,omitempty"`
+	DefaultTimeout       int    `json:"default_timeout,omitempty"`
+	NotificationTopicURN string `json:"notification_topic_urn,omitempty"`
+	NotificationMetadata string `json:"notification_metadata,omitempty"`
+}
+
+// ToLifecycleHookMap builds a request body from LifecycleHookOpts.
+func (opts LifecycleHookOpts) ToLifecycleHookMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func lifecycleHookURL(client *golangsdk.ServiceClient, groupID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"scaling_lifecycle_hook", groupID}, parts...)...)
+}
+
+func extractLifecycleHook(r golangsdk.Result) (*LifecycleHook, error) {
+	var s LifecycleHook
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateLifecycleHook creates a new lifecycle hook of the AS group.
+func CreateLifecycleHook(client *golangsdk.ServiceClient, groupID string, opts LifecycleHookOpts) (*LifecycleHook, error) {
+	b, err := opts.ToLifecycleHookMap()
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(lifecycleHookURL(client, groupID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return extractLifecycleHook(r)
+}
+
+// GetLifecycleHook retrieves the lifecycle hook of the AS group.
+func GetLifecycleHook(client *golangsdk.ServiceClient, groupID, name string) (*LifecycleHook, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(lifecycleHookURL(client, groupID, name), &r.Body, nil)
+	return extractLifecycleHook(r)
+}
+
+// UpdateLifecycleHook updates the lifecycle hook of the AS group.
+func UpdateLifecycleHook(client *golangsdk.ServiceClient, groupID, name string, opts LifecycleHookOpts) (*LifecycleHook, error) {
+	b, err := opts.ToLifecycleHookMap()
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Put(lifecycleHookURL(client, groupID, name), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return extractLifecycleHook(r)
+}
+
+// DeleteLifecycleHook deletes the lifecycle hook of the AS group.
+func DeleteLifecycleHook(client *golangsdk.ServiceClient, groupID, name string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(lifecycleHookURL(client, groupID, name), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// BatchInstancesAction performs the action (e.g. `PROTECT` or `UNPROTECT`) on the AS group instances.
+func BatchInstancesAction(client *golangsdk.ServiceClient, groupID string, instanceIDs []string, action string) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{
+		"instances_id": instanceIDs,
+		"action":       action,
+	}
+	_, r.Err = client.Post(client.ServiceURL("scaling_group_instance", groupID, "action"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// GroupUpdateOpts represents the complete body of the AS group update request.
+// Unlike groups.UpdateOpts it sends zero values, so that e.g. `cool_down_time` can be set to 0.
+type GroupUpdateOpts struct {
+	Name                      string                     `json:"scaling_group_name" required:"true"`
+	ConfigurationID           string                     `json:"scaling_configuration_id,omitempty"`
+	DesireInstanceNumber      int                        `json:"desire_instance_number"`
+	MinInstanceNumber         int                        `json:"min_instance_number"`
+	MaxInstanceNumber         int                        `json:"max_instance_number"`
+	CoolDownTime              int                        `json:"cool_down_time"`
+	LBListenerID              string                     `json:"lb_listener_id,omitempty"`
+	LBaaSListeners            []groups.LBaaSListenerOpts `json:"lbaas_listeners,omitempty"`
+	AvailableZones            []string                   `json:"available_zones,omitempty"`
+	Networks                  []groups.NetworkOpts       `json:"networks" required:"true"`
+	SecurityGroup             []groups.SecurityGroupOpts `json:"security_groups" required:"true"`
+	HealthPeriodicAuditMethod string                     `json:"health_periodic_audit_method,omitempty"`
+	HealthPeriodicAuditTime   int                        `json:"health_periodic_audit_time"`
+	HealthPeriodicAuditGrace  int                        `json:"health_periodic_audit_grace_period"`
+	InstanceTerminatePolicy   string                     `json:"instance_terminate_policy,omitempty"`
+	Notifications             []string                   `json:"notifications,omitempty"`
+	IsDeletePublicip          bool                       `json:"delete_publicip"`
+}
+
+// ToGroupUpdateMap builds a request body from GroupUpdateOpts.
+func (opts GroupUpdateOpts) ToGroupUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
@@ -0,0 +1,168 @@
+package cbr
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceCBRBackupsV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCBRBackupsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checkpoint_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"OS::Nova::Server", "OS::Cinder::Volume",
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checkpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vault_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCBRBackupsV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	listOpts := BackupListOpts{
+		VaultID:      d.Get("vault_id").(string),
+		CheckpointID: d.Get("checkpoint_id").(string),
+		ResourceID:   d.Get("resource_id").(string),
+		ResourceType: d.Get("resource_type").(string),
+		Status:       d.Get("status").(string),
+	}
+	backupList, err := ListBackups(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("error listing CBRv3 backups: %s", err)
+	}
+
+	ids := make([]string, len(backupList))
+	backups := make([]map[string]interface{}, len(backupList))
+	for i, backup := range backupList {
+		ids[i] = backup.ID
+		backups[i] = map[string]interface{}{
+			"id":            backup.ID,
+			"name":          backup.Name,
+			"description":   backup.Description,
+			"status":        backup.Status,
+			"checkpoint_id": backup.CheckpointID,
+			"vault_id":      backup.VaultID,
+			"resource_id":   backup.ResourceID,
+			"resource_name": backup.ResourceName,
+			"resource_type": backup.ResourceType,
+			"resource_size": backup.ResourceSize,
+			"image_type":    backup.ImageType,
+			"created_at":    backup.CreatedAt,
+			"expired_at":    backup.ExpiredAt,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%#v", listOpts))))
+
+	mErr := multierror.Append(nil,
+		d.Set("ids", ids),
+		d.Set("backups", backups),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting backups fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package cbr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCBRCheckpointV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCBRCheckpointV3Create,
+		Read:   resourceCBRCheckpointV3Read,
+		Delete: resourceCBRCheckpointV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCBRCheckpointV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	opts := CheckpointCreateOpts{
+		VaultID: d.Get("vault_id").(string),
+		Parameters: &CheckpointParameters{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			Incremental: d.Get("incremental").(bool),
+			Resources:   common.ExpandToStringSlice(d.Get("resources").([]interface{})),
+		},
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+
+	checkpoint, err := CreateCheckpoint(client, opts)
+	if err != nil {
+		return fmt.Errorf("error creating CBRv3 checkpoint: %s", err)
+	}
+	d.SetId(checkpoint.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"protecting"},
+		Target:     []string{"available"},
+		Refresh:    cbrCheckpointV3StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CBRv3 checkpoint %s to become available: %s", d.Id(), err)
+	}
+
+	return resourceCBRCheckpointV3Read(d, meta)
+}
+
+func cbrCheckpointV3StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		checkpoint, err := GetCheckpoint(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if checkpoint.Status == "error" {
+			return checkpoint, checkpoint.Status, fmt.Errorf("checkpoint is in error state")
+		}
+		return checkpoint, checkpoint.Status, nil
+	}
+}
+
+func resourceCBRCheckpointV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	checkpoint, err := GetCheckpoint(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error getting CBRv3 checkpoint")
+	}
+
+	backupList, err := ListBackups(client, BackupListOpts{CheckpointID: d.Id()})
+	if err != nil {
+		return fmt.Errorf("error listing CBRv3 checkpoint backups: %s", err)
+	}
+	if len(backupList) == 0 {
+		log.Printf("[WARN] CBRv3 checkpoint %s has no backups, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	backups := make([]map[string]interface{}, len(backupList))
+	for i, backup := range backupList {
+		backups[i] = map[string]interface{}{
+			"id":            backup.ID,
+			"resource_id":   backup.ResourceID,
+			"resource_type": backup.ResourceType,
+			"status":        backup.Status,
+		}
+	}
+	resources := make([]string, len(checkpoint.Vault.Resources))
+	for i, res := range checkpoint.Vault.Resources {
+		resources[i] = res.ID
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("vault_id", checkpoint.Vault.ID),
+		d.Set("status", checkpoint.Status),
+		d.Set("created_at", checkpoint.CreatedAt),
+		d.Set("backups", backups),
+	)
+	if checkpoint.ExtraInfo.Name != "" {
+		mErr = multierror.Append(mErr, d.Set("name", checkpoint.ExtraInfo.Name))
+	}
+	if len(d.Get("resources").([]interface{})) == 0 {
+		mErr = multierror.Append(mErr, d.Set("resources", resources))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting checkpoint fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCBRCheckpointV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	backupList, err := ListBackups(client, BackupListOpts{CheckpointID: d.Id()})
+	if err != nil {
+		return fmt.Errorf("error listing CBRv3 checkpoint backups: %s", err)
+	}
+	for _, backup := range backupList {
+		if err := DeleteBackup(client, backup.ID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("error deleting CBRv3 backup %s: %s", backup.ID, err)
+			}
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			backupList, err := ListBackups(client, BackupListOpts{CheckpointID: d.Id()})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(backupList) == 0 {
+				return backupList, "deleted", nil
+			}
+			return backupList, "deleting", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CBRv3 checkpoint %s backups to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
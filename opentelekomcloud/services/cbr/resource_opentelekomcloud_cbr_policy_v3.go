@@ -21,6 +21,8 @@ func ResourceCBRPolicyV3() *schema.Resource {
 		Update: resourceCBRPolicyV3Update,
 		Delete: resourceCBRPolicyV3Delete,
 
+		CustomizeDiff: validateCBRPolicyV3Replication,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -83,6 +85,14 @@ func ResourceCBRPolicyV3() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.IntBetween(0, 100),
 						},
+						"destination_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -105,11 +115,11 @@ func ResourceCBRPolicyV3() *schema.Resource {
 	}
 }
 
-func resourceCBRPolicyV3OpDefinition(d *schema.ResourceData) *policies.PolicyODCreate {
+func resourceCBRPolicyV3OpDefinition(d *schema.ResourceData) *PolicyOperationDefinition {
 	opDefinitionRaw := d.Get("operation_definition").([]interface{})
 	if len(opDefinitionRaw) == 1 {
 		opDefinition := opDefinitionRaw[0].(map[string]interface{})
-		return &policies.PolicyODCreate{
+		return &PolicyOperationDefinition{
 			DailyBackups:          opDefinition["day_backups"].(int),
 			WeekBackups:           opDefinition["week_backups"].(int),
 			YearBackups:           opDefinition["year_backups"].(int),
@@ -117,9 +127,11 @@ func resourceCBRPolicyV3OpDefinition(d *schema.ResourceData) *policies.PolicyODC
 			MaxBackups:            opDefinition["max_backups"].(int),
 			RetentionDurationDays: opDefinition["retention_duration_days"].(int),
 			Timezone:              opDefinition["timezone"].(string),
+			DestinationRegion:     opDefinition["destination_region"].(string),
+			DestinationProjectID:  opDefinition["destination_project_id"].(string),
 		}
 	}
-	return &policies.PolicyODCreate{
+	return &PolicyOperationDefinition{
 		Timezone: "UTC+00:00",
 	}
 }
@@ -142,7 +154,7 @@ func resourceCBRPolicyV3Create(d *schema.ResourceData, meta interface{}) error {
 
 	enabled := d.Get("enabled").(bool)
 
-	createOpts := policies.CreateOpts{
+	createOpts := PolicyCreateOpts{
 		Name:                d.Get("name").(string),
 		OperationDefinition: resourceCBRPolicyV3OpDefinition(d),
 		Enabled:             &enabled,
@@ -173,7 +185,7 @@ func resourceCBRPolicyV3Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
 	}
 
-	cbrPolicy, err := policies.Get(client, d.Id()).Extract()
+	cbrPolicy, err := GetPolicy(client, d.Id())
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			log.Printf("[WARN] Removing CBR policy %s as it's already gone", d.Id())
@@ -205,6 +217,8 @@ func resourceCBRPolicyV3Read(d *schema.ResourceData, meta interface{}) error {
 	opDefinition["timezone"] = cbrPolicyOD.Timezone
 	opDefinition["week_backups"] = cbrPolicyOD.WeekBackups
 	opDefinition["year_backups"] = cbrPolicyOD.YearBackups
+	opDefinition["destination_region"] = cbrPolicyOD.DestinationRegion
+	opDefinition["destination_project_id"] = cbrPolicyOD.DestinationProjectID
 	opDefinitionList = append(opDefinitionList, opDefinition)
 	if err := d.Set("operation_definition", opDefinitionList); err != nil {
 		return fmt.Errorf("error setting operetion_definition: %s", err)
@@ -220,7 +234,7 @@ func resourceCBRPolicyV3Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
 	}
 
-	var updateOpts policies.UpdateOpts
+	var updateOpts PolicyUpdateOpts
 
 	if d.HasChange("name") {
 		newName := d.Get("name")
@@ -270,3 +284,24 @@ func resourceCBRPolicyV3Delete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+func validateCBRPolicyV3Replication(d *schema.ResourceDiff, meta interface{}) error {
+	destinationRegion := d.Get("operation_definition.0.destination_region").(string)
+	destinationProjectID := d.Get("operation_definition.0.destination_project_id").(string)
+
+	if d.Get("operation_type").(string) != "replication" {
+		if destinationRegion != "" || destinationProjectID != "" {
+			return fmt.Errorf("destination_region and destination_project_id can be set only for the replication policy")
+		}
+		return nil
+	}
+
+	if destinationRegion == "" || destinationProjectID == "" {
+		return fmt.Errorf("destination_region and destination_project_id are required for the replication policy")
+	}
+	config := meta.(*cfg.Config)
+	if destinationRegion == config.GetRegion(d) {
+		return fmt.Errorf("destination_region of the replication policy must differ from the policy region %s", destinationRegion)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package cbr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// ResourceCBRRestoreV3 restores an ECS or an EVS disk from the backup on create.
+// Deleting the resource only removes it from the state.
+func ResourceCBRRestoreV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCBRRestoreV3Create,
+		Read:   resourceCBRRestoreV3Read,
+		Delete: resourceCBRRestoreV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"server_id", "volume_id"},
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"power_on": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       true,
+				ConflictsWith: []string{"volume_id"},
+			},
+			"mapping": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"volume_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCBRRestoreV3Mappings(d *schema.ResourceData) []RestoreMapping {
+	rawMappings := d.Get("mapping").([]interface{})
+	mappings := make([]RestoreMapping, len(rawMappings))
+	for i, raw := range rawMappings {
+		mapping := raw.(map[string]interface{})
+		mappings[i] = RestoreMapping{
+			BackupID: mapping["backup_id"].(string),
+			VolumeID: mapping["volume_id"].(string),
+		}
+	}
+	return mappings
+}
+
+func resourceCBRRestoreV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	backupID := d.Get("backup_id").(string)
+	opts := RestoreOpts{
+		ServerID: d.Get("server_id").(string),
+		VolumeID: d.Get("volume_id").(string),
+		Mappings: resourceCBRRestoreV3Mappings(d),
+	}
+	if opts.ServerID != "" {
+		powerOn := d.Get("power_on").(bool)
+		opts.PowerOn = &powerOn
+	}
+	log.Printf("[DEBUG] Restore Options: %#v", opts)
+
+	if err := RestoreBackup(client, backupID, opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error restoring CBRv3 backup %s: %s", backupID, err)
+	}
+	// the restore API doesn't return any job ID, so the restore start time
+	// makes the ID unique for the same backup restored several times
+	d.SetId(fmt.Sprintf("%s/%d", backupID, time.Now().UTC().Unix()))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"restoring"},
+		Target:     []string{"available"},
+		Refresh:    cbrBackupV3StateRefreshFunc(client, backupID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CBRv3 backup %s to be restored: %s", backupID, err)
+	}
+
+	return resourceCBRRestoreV3Read(d, meta)
+}
+
+func cbrBackupV3StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := GetBackup(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if backup.Status == "error" {
+			return backup, backup.Status, fmt.Errorf("backup is in error state")
+		}
+		return backup, backup.Status, nil
+	}
+}
+
+func resourceCBRRestoreV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CbrV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client: %s", err)
+	}
+
+	if _, err := GetBackup(client, d.Get("backup_id").(string)); err != nil {
+		return common.CheckDeleted(d, err, "error getting CBRv3 backup")
+	}
+
+	return nil
+}
+
+func resourceCBRRestoreV3Delete(d *schema.ResourceData, _ interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -204,6 +204,16 @@ func ResourceCBRVaultV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"replication_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"destination_vault_id"},
+			},
+			"destination_vault_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"replication_policy_id"},
+			},
 			"tags": common.TagsSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
@@ -352,6 +362,12 @@ func resourceCBRVaultV3Create(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if policy := d.Get("replication_policy_id").(string); policy != "" {
+		if err := bindReplicationPolicy(d, config, client, policy); err != nil {
+			return err
+		}
+	}
+
 	return resourceCBRVaultV3Read(d, meta)
 }
 
@@ -570,6 +586,64 @@ func updatePolicy(d *schema.ResourceData, client *golangsdk.ServiceClient) error
 	return nil
 }
 
+// validateDestinationVault checks that the destination vault of the replication policy
+// exists in the destination region of the policy
+func validateDestinationVault(config *cfg.Config, client *golangsdk.ServiceClient, policyID, vaultID string) error {
+	policy, err := GetPolicy(client, policyID)
+	if err != nil {
+		return fmt.Errorf("error getting replication policy %s: %s", policyID, err)
+	}
+	if policy.OperationType != "replication" {
+		return fmt.Errorf("policy %s is not a replication policy", policyID)
+	}
+	if policy.OperationDefinition == nil || policy.OperationDefinition.DestinationRegion == "" {
+		return fmt.Errorf("replication policy %s has no destination region", policyID)
+	}
+
+	destinationClient, err := config.CbrV3Client(policy.OperationDefinition.DestinationRegion)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CBRv3 client for destination region: %s", err)
+	}
+	if _, err := vaults.Get(destinationClient, vaultID).Extract(); err != nil {
+		return fmt.Errorf("error getting destination vault %s in region %s: %s",
+			vaultID, policy.OperationDefinition.DestinationRegion, err)
+	}
+	return nil
+}
+
+func bindReplicationPolicy(d *schema.ResourceData, config *cfg.Config, client *golangsdk.ServiceClient, policyID string) error {
+	destinationVaultID := d.Get("destination_vault_id").(string)
+	if err := validateDestinationVault(config, client, policyID, destinationVaultID); err != nil {
+		return err
+	}
+	_, err := vaults.BindPolicy(client, d.Id(), BindPolicyOpts{
+		PolicyID:           policyID,
+		DestinationVaultID: destinationVaultID,
+	}).Extract()
+	if err != nil {
+		return fmt.Errorf("error binding replication policy to vault: %s", err)
+	}
+	return nil
+}
+
+func updateReplicationPolicy(d *schema.ResourceData, config *cfg.Config, client *golangsdk.ServiceClient) error {
+	oldP, newP := d.GetChange("replication_policy_id")
+	if oldP != "" {
+		_, err := vaults.UnbindPolicy(client, d.Id(), vaults.BindPolicyOpts{
+			PolicyID: oldP.(string),
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("error unbinding replication policy from vault: %s", err)
+		}
+	}
+	if newP != "" {
+		if err := bindReplicationPolicy(d, config, client, newP.(string)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func resourceCBRVaultV3Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.CbrV3Client(config.GetRegion(d))
@@ -629,6 +703,12 @@ func resourceCBRVaultV3Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChanges("replication_policy_id", "destination_vault_id") {
+		if err := updateReplicationPolicy(d, config, client); err != nil {
+			return err
+		}
+	}
+
 	return resourceCBRVaultV3Read(d, meta)
 }
 
@@ -0,0 +1,285 @@
+package cbr
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/policies"
+)
+
+// CheckpointParameters represents the backup parameters of the CBR checkpoint.
+type CheckpointParameters struct {
+	AutoTrigger bool     `json:"auto_trigger"`
+	Description string   `json:"description,omitempty"`
+	Incremental bool     `json:"incremental"`
+	Name        string   `json:"name,omitempty"`
+	Resources   []string `json:"resources,omitempty"`
+}
+
+// CheckpointCreateOpts represents the attributes used when creating a new CBR checkpoint.
+type CheckpointCreateOpts struct {
+	VaultID    string                `json:"vault_id" required:"true"`
+	Parameters *CheckpointParameters `json:"parameters,omitempty"`
+}
+
+// ToCheckpointCreateMap builds a request body from CheckpointCreateOpts.
+func (opts CheckpointCreateOpts) ToCheckpointCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "checkpoint")
+}
+
+// CheckpointResource represents the vault resource included in the CBR checkpoint.
+type CheckpointResource struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	ProtectStatus string `json:"protect_status"`
+	ResourceSize  string `json:"resource_size"`
+	BackupSize    string `json:"backup_size"`
+	BackupCount   string `json:"backup_count"`
+}
+
+// CheckpointVault represents the vault of the CBR checkpoint.
+type CheckpointVault struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	Resources []CheckpointResource `json:"resources"`
+}
+
+// CheckpointExtraInfo represents the extra information of the CBR checkpoint.
+type CheckpointExtraInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Checkpoint represents the CBR checkpoint, i.e. a set of backups created at once.
+type Checkpoint struct {
+	ID        string              `json:"id"`
+	CreatedAt string              `json:"created_at"`
+	ProjectID string              `json:"project_id"`
+	Status    string              `json:"status"`
+	Vault     CheckpointVault     `json:"vault"`
+	ExtraInfo CheckpointExtraInfo `json:"extra_info"`
+}
+
+// CreateCheckpoint creates an on-demand backup of the vault resources.
+func CreateCheckpoint(client *golangsdk.ServiceClient, opts CheckpointCreateOpts) (*Checkpoint, error) {
+	b, err := opts.ToCheckpointCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("checkpoints"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	var s struct {
+		Checkpoint Checkpoint `json:"checkpoint"`
+	}
+	err = r.ExtractInto(&s)
+	return &s.Checkpoint, err
+}
+
+// GetCheckpoint retrieves the CBR checkpoint details.
+func GetCheckpoint(client *golangsdk.ServiceClient, checkpointID string) (*Checkpoint, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("checkpoints", checkpointID), &r.Body, nil)
+
+	var s struct {
+		Checkpoint Checkpoint `json:"checkpoint"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Checkpoint, err
+}
+
+// BackupListOpts represents the query parameters used when listing CBR backups.
+type BackupListOpts struct {
+	CheckpointID string `q:"checkpoint_id"`
+	ResourceID   string `q:"resource_id"`
+	ResourceType string `q:"resource_type"`
+	ResourceName string `q:"resource_name"`
+	VaultID      string `q:"vault_id"`
+	Status       string `q:"status"`
+	Name         string `q:"name"`
+	ImageType    string `q:"image_type"`
+	Limit        int    `q:"limit"`
+	Offset       int    `q:"offset"`
+}
+
+// backupsPageLimit is the page size used when listing CBR backups
+const backupsPageLimit = 100
+
+// Backup represents the backup of the single resource in the CBR vault.
+type Backup struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Status       string `json:"status"`
+	CheckpointID string `json:"checkpoint_id"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	ResourceType string `json:"resource_type"`
+	ResourceSize int    `json:"resource_size"`
+	VaultID      string `json:"vault_id"`
+	ImageType    string `json:"image_type"`
+	CreatedAt    string `json:"created_at"`
+	ExpiredAt    string `json:"expired_at"`
+}
+
+// ListBackups retrieves all the CBR backups matching the given options, page by page.
+func ListBackups(client *golangsdk.ServiceClient, opts BackupListOpts) ([]Backup, error) {
+	if opts.Limit == 0 {
+		opts.Limit = backupsPageLimit
+	}
+
+	var backups []Backup
+	for {
+		q, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("backups")+q.String(), &r.Body, nil)
+
+		var s struct {
+			Backups []Backup `json:"backups"`
+			Count   int      `json:"count"`
+		}
+		if err := r.ExtractInto(&s); err != nil {
+			return nil, err
+		}
+		backups = append(backups, s.Backups...)
+
+		if len(s.Backups) < opts.Limit || (s.Count > 0 && len(backups) >= s.Count) {
+			return backups, nil
+		}
+		opts.Offset += len(s.Backups)
+	}
+}
+
+// GetBackup retrieves the CBR backup details.
+func GetBackup(client *golangsdk.ServiceClient, backupID string) (*Backup, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("backups", backupID), &r.Body, nil)
+
+	var s struct {
+		Backup Backup `json:"backup"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Backup, err
+}
+
+// DeleteBackup deletes the CBR backup.
+func DeleteBackup(client *golangsdk.ServiceClient, backupID string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("backups", backupID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// RestoreMapping represents the disk to be restored from the ECS backup.
+type RestoreMapping struct {
+	BackupID string `json:"backup_id" required:"true"`
+	VolumeID string `json:"volume_id" required:"true"`
+}
+
+// RestoreOpts represents the attributes used when restoring the resource from the CBR backup.
+type RestoreOpts struct {
+	Mappings []RestoreMapping `json:"mappings,omitempty"`
+	PowerOn  *bool            `json:"power_on,omitempty"`
+	ServerID string           `json:"server_id,omitempty"`
+	VolumeID string           `json:"volume_id,omitempty"`
+}
+
+// ToRestoreMap builds a request body from RestoreOpts.
+func (opts RestoreOpts) ToRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "restore")
+}
+
+// RestoreBackup restores the ECS or the EVS disk from the CBR backup.
+func RestoreBackup(client *golangsdk.ServiceClient, backupID string, opts RestoreOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("backups", backupID, "restore"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// PolicyOperationDefinition represents the scheduling configuration of the CBR policy.
+// DestinationRegion and DestinationProjectID are used by the replication policy only.
+type PolicyOperationDefinition struct {
+	DailyBackups          int    `json:"day_backups"`
+	WeekBackups           int    `json:"week_backups"`
+	YearBackups           int    `json:"year_backups"`
+	MonthBackups          int    `json:"month_backups"`
+	MaxBackups            int    `json:"max_backups,omitempty"`
+	RetentionDurationDays int    `json:"retention_duration_days,omitempty"`
+	Timezone              string `json:"timezone,omitempty"`
+	DestinationRegion     string `json:"destination_region,omitempty"`
+	DestinationProjectID  string `json:"destination_project_id,omitempty"`
+}
+
+// PolicyCreateOpts represents the attributes used when creating a new CBR policy.
+type PolicyCreateOpts struct {
+	Name                string                     `json:"name" required:"true"`
+	OperationDefinition *PolicyOperationDefinition `json:"operation_definition" required:"true"`
+	Enabled             *bool                      `json:"enabled,omitempty"`
+	OperationType       policies.OperationType     `json:"operation_type" required:"true"`
+	Trigger             *policies.Trigger          `json:"trigger" required:"true"`
+}
+
+// ToPolicyCreateMap builds a request body from PolicyCreateOpts.
+func (opts PolicyCreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "policy")
+}
+
+// PolicyUpdateOpts represents the attributes used when updating the CBR policy.
+type PolicyUpdateOpts struct {
+	Enabled             *bool                      `json:"enabled,omitempty"`
+	Name                string                     `json:"name,omitempty"`
+	OperationDefinition *PolicyOperationDefinition `json:"operation_definition,omitempty"`
+	Trigger             *policies.Trigger          `json:"trigger,omitempty"`
+}
+
+// ToPolicyUpdateMap builds a request body from PolicyUpdateOpts.
+func (opts PolicyUpdateOpts) ToPolicyUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "policy")
+}
+
+// Policy represents the CBR policy including the replication destination.
+type Policy struct {
+	ID                  string                          `json:"id"`
+	Name                string                          `json:"name"`
+	Enabled             bool                            `json:"enabled"`
+	OperationDefinition *PolicyOperationDefinition      `json:"operation_definition"`
+	OperationType       policies.OperationType          `json:"operation_type"`
+	Trigger             *policies.PolicyTriggerResp     `json:"trigger"`
+	AssociatedVaults    []policies.PolicyAssociateVault `json:"associated_vaults"`
+}
+
+// GetPolicy retrieves the CBR policy details.
+func GetPolicy(client *golangsdk.ServiceClient, policyID string) (*Policy, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("policies", policyID), &r.Body, nil)
+
+	var s struct {
+		Policy Policy `json:"policy"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Policy, err
+}
+
+// BindPolicyOpts represents the attributes used when associating the policy with the vault.
+// DestinationVaultID is mandatory for the replication policy.
+type BindPolicyOpts struct {
+	PolicyID           string `json:"policy_id" required:"true"`
+	DestinationVaultID string `json:"destination_vault_id,omitempty"`
+}
+
+// ToBindPolicyMap builds a request body from BindPolicyOpts.
+func (opts BindPolicyOpts) ToBindPolicyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
@@ -0,0 +1,188 @@
+package ces
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metricdata"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceCesMetricDataV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCesMetricDataV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dimensions": metricDimensionsSchema(true),
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"average", "max", "min", "sum", "variance",
+				}, false),
+			},
+			"datapoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"min": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"max": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"average": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// toUnixMilli converts RFC3339 time to the UNIX timestamp in milliseconds used by CES
+func toUnixMilli(value string) (string, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10), nil
+}
+
+func datapointValue(point MetricDatapoint, filter string) float64 {
+	switch filter {
+	case "max":
+		return point.Max
+	case "min":
+		return point.Min
+	case "sum":
+		return point.Sum
+	case "variance":
+		return point.Variance
+	default:
+		return point.Average
+	}
+}
+
+func dataSourceCesMetricDataV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	from, err := toUnixMilli(d.Get("from").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing from: %s", err)
+	}
+	to, err := toUnixMilli(d.Get("to").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing to: %s", err)
+	}
+
+	filter := d.Get("filter").(string)
+	dims := getMetricDimensionFilters(d)
+	opts := metricdata.GetOpts{
+		Namespace:  d.Get("namespace").(string),
+		MetricName: d.Get("metric_name").(string),
+		Dim0:       dims[0],
+		Dim1:       dims[1],
+		Dim2:       dims[2],
+		From:       from,
+		To:         to,
+		Period:     strconv.Itoa(d.Get("period").(int)),
+		Filter:     filter,
+	}
+	data, err := GetMetricData(client, opts)
+	if err != nil {
+		return fmt.Errorf("error getting CES metric data: %s", err)
+	}
+
+	var minValue, maxValue, sum float64
+	datapoints := make([]map[string]interface{}, len(data.Datapoints))
+	for i, point := range data.Datapoints {
+		value := datapointValue(point, filter)
+		if i == 0 || value < minValue {
+			minValue = value
+		}
+		if i == 0 || value > maxValue {
+			maxValue = value
+		}
+		sum += value
+		datapoints[i] = map[string]interface{}{
+			"timestamp": point.Timestamp,
+			"value":     value,
+			"unit":      point.Unit,
+		}
+	}
+	var average float64
+	if len(data.Datapoints) > 0 {
+		average = sum / float64(len(data.Datapoints))
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%#v", opts))))
+
+	mErr := multierror.Append(nil,
+		d.Set("datapoints", datapoints),
+		d.Set("min", minValue),
+		d.Set("max", maxValue),
+		d.Set("average", average),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CES metric data fields: %s", err)
+	}
+
+	return nil
+}
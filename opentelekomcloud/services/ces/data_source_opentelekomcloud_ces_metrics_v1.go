@@ -0,0 +1,159 @@
+package ces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metrics"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceCesMetricsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCesMetricsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dimensions": metricDimensionsSchema(false),
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// metricDimensionsSchema is the schema of the dimensions used to filter the metrics
+func metricDimensionsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: !required,
+		Required: required,
+		MaxItems: 3,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// getMetricDimensionFilters returns the dimensions in the `dim.N` query parameter format
+func getMetricDimensionFilters(d *schema.ResourceData) [3]string {
+	var dims [3]string
+	for i, raw := range d.Get("dimensions").([]interface{}) {
+		dimension := raw.(map[string]interface{})
+		dims[i] = fmt.Sprintf("%s,%s", dimension["name"], dimension["value"])
+	}
+	return dims
+}
+
+func dataSourceCesMetricsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	limit := 1000
+	dims := getMetricDimensionFilters(d)
+	listOpts := metrics.ListOpts{
+		Namespace:  d.Get("namespace").(string),
+		MetricName: d.Get("metric_name").(string),
+		Dim0:       dims[0],
+		Dim1:       dims[1],
+		Dim2:       dims[2],
+		Limit:      &limit,
+	}
+	pages, err := metrics.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error listing CES metrics: %s", err)
+	}
+	metricList, err := metrics.ExtractAllPagesMetrics(pages)
+	if err != nil {
+		return fmt.Errorf("error extracting CES metrics: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(metricList.Metrics))
+	for i, metric := range metricList.Metrics {
+		dimensions := make([]map[string]interface{}, len(metric.Dimensions))
+		for j, dimension := range metric.Dimensions {
+			dimensions[j] = map[string]interface{}{
+				"name":  dimension.Name,
+				"value": dimension.Value,
+			}
+		}
+		result[i] = map[string]interface{}{
+			"namespace":   metric.Namespace,
+			"metric_name": metric.MetricName,
+			"unit":        metric.Unit,
+			"dimensions":  dimensions,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s/%s/%v", listOpts.Namespace, listOpts.MetricName, dims))))
+
+	mErr := multierror.Append(nil,
+		d.Set("metrics", result),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CES metrics fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,573 @@
+package ces
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cloudeyeservice/alarmrule"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// ResourceCesAlarmTemplateV1 manages the CES alarm template. When resource_group_id is set,
+// the template is applied to the group: an alarm rule is created for every policy
+// and every group resource of the policy namespace. Alarm rules follow the group
+// membership: rules of removed resources are deleted and rules of new resources are created.
+func ResourceCesAlarmTemplateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCesAlarmTemplateV1Create,
+		Read:   resourceCesAlarmTemplateV1Read,
+		Update: resourceCesAlarmTemplateV1Update,
+		Delete: resourceCesAlarmTemplateV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: diffCesAlarmTemplateV1Rules,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					resourceGroupNameRegexp,
+					"must be string of 1 to 128 characters that consists of letters, digits, hyphens(-) and underscores(_)",
+				),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"dimension_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"max", "min", "average", "sum", "variance",
+							}, false),
+						},
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								">", "=", "<", ">=", "<=",
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"alarm_level": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(1, 4),
+						},
+					},
+				},
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"alarm_actions": alarmActionsSchema(),
+			"ok_actions":    alarmActionsSchema(),
+			"alarm_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"alarm_action_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"alarm_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alarm_targets": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandAlarmTemplatePolicies(rawPolicies []interface{}) []AlarmTemplatePolicy {
+	policies := make([]AlarmTemplatePolicy, len(rawPolicies))
+	for i, raw := range rawPolicies {
+		policy := raw.(map[string]interface{})
+		policies[i] = AlarmTemplatePolicy{
+			Namespace:          policy["namespace"].(string),
+			DimensionName:      policy["dimension_name"].(string),
+			MetricName:         policy["metric_name"].(string),
+			Period:             policy["period"].(int),
+			Filter:             policy["filter"].(string),
+			ComparisonOperator: policy["comparison_operator"].(string),
+			Value:              policy["value"].(int),
+			Unit:               policy["unit"].(string),
+			Count:              policy["count"].(int),
+			AlarmLevel:         policy["alarm_level"].(int),
+		}
+	}
+	return policies
+}
+
+func flattenAlarmTemplatePolicies(policies []AlarmTemplatePolicy) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(policies))
+	for i, policy := range policies {
+		result[i] = map[string]interface{}{
+			"namespace":           policy.Namespace,
+			"dimension_name":      policy.DimensionName,
+			"metric_name":         policy.MetricName,
+			"period":              policy.Period,
+			"filter":              policy.Filter,
+			"comparison_operator": policy.ComparisonOperator,
+			"value":               policy.Value,
+			"unit":                policy.Unit,
+			"count":               policy.Count,
+			"alarm_level":         policy.AlarmLevel,
+		}
+	}
+	return result
+}
+
+func flattenAlarmTemplateActions(actions []alarmrule.ActionInfo) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(actions))
+	for i, action := range actions {
+		result[i] = map[string]interface{}{
+			"type":              action.Type,
+			"notification_list": action.NotificationList,
+		}
+	}
+	return result
+}
+
+func getAlarmTemplateOpts(d *schema.ResourceData) AlarmTemplateOpts {
+	return AlarmTemplateOpts{
+		TemplateName:        d.Get("name").(string),
+		TemplateDescription: d.Get("description").(string),
+		Policies:            expandAlarmTemplatePolicies(d.Get("policy").([]interface{})),
+	}
+}
+
+func alarmTemplateRuleDescription(templateID string) string {
+	return fmt.Sprintf("Created from CES alarm template %s", templateID)
+}
+
+// alarmTemplateTarget is the alarm rule required for the template policy and the group resource
+type alarmTemplateTarget struct {
+	policy     AlarmTemplatePolicy
+	dimensions []alarmrule.DimensionOpts
+}
+
+// alarmTemplateTargetKey identifies the alarm rule by the policy index and the resource dimensions,
+// e.g. `0/SYS.ECS/instance_id=<id>`
+func alarmTemplateTargetKey(policyIndex int, namespace string, dimensions []alarmrule.DimensionOpts) string {
+	values := make([]string, len(dimensions))
+	for i, dimension := range dimensions {
+		values[i] = fmt.Sprintf("%s=%s", dimension.Name, dimension.Value)
+	}
+	return fmt.Sprintf("%d/%s/%s", policyIndex, namespace, strings.Join(values, ","))
+}
+
+// expectedAlarmTemplateTargets returns the alarm rules required for every policy
+// and every resource of the group in the policy namespace
+func expectedAlarmTemplateTargets(client *golangsdk.ServiceClient, groupID string, policies []AlarmTemplatePolicy) (map[string]alarmTemplateTarget, error) {
+	targets := make(map[string]alarmTemplateTarget)
+	if groupID == "" {
+		return targets, nil
+	}
+	group, err := GetResourceGroup(client, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting CES resource group %s: %s", groupID, err)
+	}
+
+	for _, res := range group.Resources {
+		for i, policy := range policies {
+			if policy.Namespace != res.Namespace {
+				continue
+			}
+			var dimensions []alarmrule.DimensionOpts
+			for _, dimension := range res.Dimensions {
+				if dimension.Name == policy.DimensionName {
+					dimensions = append(dimensions, alarmrule.DimensionOpts{
+						Name:  dimension.Name,
+						Value: dimension.Value,
+					})
+				}
+			}
+			if len(dimensions) == 0 {
+				continue
+			}
+			key := alarmTemplateTargetKey(i, policy.Namespace, dimensions)
+			targets[key] = alarmTemplateTarget{policy: policy, dimensions: dimensions}
+		}
+	}
+	return targets, nil
+}
+
+// alarmTemplateRuleKey finds the target key of the alarm rule created from the template,
+// rules not matching any policy get the `-1` policy index
+func alarmTemplateRuleKey(rule AlarmRuleSummary, policies []AlarmTemplatePolicy) string {
+	for i, policy := range policies {
+		if policy.Namespace != rule.Metric.Namespace || policy.MetricName != rule.Metric.MetricName ||
+			policy.Period != rule.Condition.Period || policy.Filter != rule.Condition.Filter ||
+			policy.ComparisonOperator != rule.Condition.ComparisonOperator ||
+			float64(policy.Value) != rule.Condition.Value || policy.Count != rule.Condition.Count {
+			continue
+		}
+		var dimensions []alarmrule.DimensionOpts
+		for _, dimension := range rule.Metric.Dimensions {
+			if dimension.Name == policy.DimensionName {
+				dimensions = append(dimensions, alarmrule.DimensionOpts{Name: dimension.Name, Value: dimension.Value})
+			}
+		}
+		if len(dimensions) != 0 {
+			return alarmTemplateTargetKey(i, policy.Namespace, dimensions)
+		}
+	}
+
+	dimensions := make([]alarmrule.DimensionOpts, len(rule.Metric.Dimensions))
+	for i, dimension := range rule.Metric.Dimensions {
+		dimensions[i] = alarmrule.DimensionOpts{Name: dimension.Name, Value: dimension.Value}
+	}
+	return alarmTemplateTargetKey(-1, rule.Metric.Namespace, dimensions)
+}
+
+func createAlarmTemplateRule(client *golangsdk.ServiceClient, d *schema.ResourceData, key string, target alarmTemplateTarget) (string, error) {
+	// alarm rule names can't contain hyphens and are limited to 128 characters
+	prefix := strings.ReplaceAll(d.Get("name").(string), "-", "_")
+	if len(prefix) > 100 {
+		prefix = prefix[:100]
+	}
+
+	createOpts := alarmrule.CreateOpts{
+		AlarmName:        fmt.Sprintf("%s_%d", prefix, hashcode.String(key)),
+		AlarmDescription: alarmTemplateRuleDescription(d.Id()),
+		AlarmLevel:       target.policy.AlarmLevel,
+		Metric: alarmrule.MetricOpts{
+			Namespace:  target.policy.Namespace,
+			MetricName: target.policy.MetricName,
+			Dimensions: target.dimensions,
+		},
+		Condition: alarmrule.ConditionOpts{
+			Period:             target.policy.Period,
+			Filter:             target.policy.Filter,
+			ComparisonOperator: target.policy.ComparisonOperator,
+			Value:              target.policy.Value,
+			Unit:               target.policy.Unit,
+			Count:              target.policy.Count,
+		},
+		AlarmActions:       getAlarmAction(d, "alarm_actions"),
+		OkActions:          getAlarmAction(d, "ok_actions"),
+		AlarmEnabled:       d.Get("alarm_enabled").(bool),
+		AlarmActionEnabled: d.Get("alarm_action_enabled").(bool),
+	}
+	log.Printf("[DEBUG] Create %s Options: %#v", nameCESAR, createOpts)
+
+	rule, err := alarmrule.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error creating %s for %s: %s", nameCESAR, key, err)
+	}
+	return rule.AlarmID, nil
+}
+
+func deleteAlarmTemplateRule(client *golangsdk.ServiceClient, alarmID string) error {
+	if err := alarmrule.Delete(client, alarmID).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("error deleting %s %s: %s", nameCESAR, alarmID, err)
+		}
+	}
+	return nil
+}
+
+// syncAlarmTemplateRules deletes the alarm rules of the resources which left the group
+// and creates the rules for the new group resources. When recreate is set, all the rules are replaced.
+// The targets map is updated with every created and deleted rule.
+func syncAlarmTemplateRules(client *golangsdk.ServiceClient, d *schema.ResourceData, targets map[string]string, recreate bool) error {
+	policies := expandAlarmTemplatePolicies(d.Get("policy").([]interface{}))
+	expected, err := expectedAlarmTemplateTargets(client, d.Get("resource_group_id").(string), policies)
+	if err != nil {
+		return err
+	}
+
+	for key, alarmID := range targets {
+		if _, ok := expected[key]; ok && !recreate {
+			continue
+		}
+		if err := deleteAlarmTemplateRule(client, alarmID); err != nil {
+			return err
+		}
+		delete(targets, key)
+	}
+
+	for key, target := range expected {
+		if _, ok := targets[key]; ok {
+			continue
+		}
+		alarmID, err := createAlarmTemplateRule(client, d, key, target)
+		if err != nil {
+			return err
+		}
+		targets[key] = alarmID
+	}
+	return nil
+}
+
+func setAlarmTemplateTargets(d *schema.ResourceData, targets map[string]string) error {
+	keys := make([]string, 0, len(targets))
+	for key := range targets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	alarmIDs := make([]string, len(keys))
+	for i, key := range keys {
+		alarmIDs[i] = targets[key]
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("alarm_targets", targets),
+		d.Set("alarm_ids", alarmIDs),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func expandAlarmTemplateTargets(raw interface{}) map[string]string {
+	targets := make(map[string]string)
+	for key, alarmID := range raw.(map[string]interface{}) {
+		targets[key] = alarmID.(string)
+	}
+	return targets
+}
+
+// diffCesAlarmTemplateV1Rules plans the update of the alarm rules when the rule settings change
+// or the resources of the group differ from the resources having the rules
+func diffCesAlarmTemplateV1Rules(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	changed := false
+	for _, key := range []string{"name", "policy", "resource_group_id", "alarm_actions", "ok_actions", "alarm_enabled", "alarm_action_enabled"} {
+		changed = changed || d.HasChange(key)
+	}
+	if !changed && d.NewValueKnown("resource_group_id") && d.NewValueKnown("policy") {
+		config := meta.(*cfg.Config)
+		client, err := config.CesV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+		}
+		policies := expandAlarmTemplatePolicies(d.Get("policy").([]interface{}))
+		expected, err := expectedAlarmTemplateTargets(client, d.Get("resource_group_id").(string), policies)
+		if err != nil {
+			return err
+		}
+		current := expandAlarmTemplateTargets(d.Get("alarm_targets"))
+		changed = len(current) != len(expected)
+		for key := range expected {
+			if _, ok := current[key]; !ok {
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.SetNewComputed("alarm_targets"),
+		d.SetNewComputed("alarm_ids"),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceCesAlarmTemplateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	opts := getAlarmTemplateOpts(d)
+	log.Printf("[DEBUG] Create CES alarm template options: %#v", opts)
+
+	templateID, err := CreateAlarmTemplate(client, opts)
+	if err != nil {
+		return fmt.Errorf("error creating CES alarm template: %s", err)
+	}
+	d.SetId(templateID)
+
+	targets := make(map[string]string)
+	err = syncAlarmTemplateRules(client, d, targets, false)
+	if setErr := setAlarmTemplateTargets(d, targets); setErr != nil {
+		return fmt.Errorf("error setting alarm_targets: %s", setErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceCesAlarmTemplateV1Read(d, meta)
+}
+
+func resourceCesAlarmTemplateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	template, err := GetAlarmTemplate(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error getting CES alarm template")
+	}
+	log.Printf("[DEBUG] Retrieved CES alarm template %s: %#v", d.Id(), template)
+
+	rules, err := ListAlarmRules(client)
+	if err != nil {
+		return fmt.Errorf("error listing %s: %s", nameCESAR, err)
+	}
+	// rules are matched by the ID stored in the state, rules unknown
+	// to the state (e.g. after import) are matched by the policy
+	keys := make(map[string]string)
+	for key, alarmID := range expandAlarmTemplateTargets(d.Get("alarm_targets")) {
+		keys[alarmID] = key
+	}
+	targets := make(map[string]string)
+	var templateRules []AlarmRuleSummary
+	for _, rule := range rules {
+		if rule.AlarmDescription != alarmTemplateRuleDescription(d.Id()) {
+			continue
+		}
+		key, ok := keys[rule.AlarmID]
+		if !ok {
+			key = alarmTemplateRuleKey(rule, template.Policies)
+		}
+		targets[key] = rule.AlarmID
+		templateRules = append(templateRules, rule)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", template.TemplateName),
+		d.Set("description", template.TemplateDescription),
+		d.Set("policy", flattenAlarmTemplatePolicies(template.Policies)),
+		setAlarmTemplateTargets(d, targets),
+		d.Set("region", config.GetRegion(d)),
+	)
+	// all the rules share the same actions, the first one is used to detect the changes
+	if len(templateRules) > 0 {
+		rule := templateRules[0]
+		mErr = multierror.Append(mErr,
+			d.Set("alarm_actions", flattenAlarmTemplateActions(rule.AlarmActions)),
+			d.Set("ok_actions", flattenAlarmTemplateActions(rule.OkActions)),
+			d.Set("alarm_enabled", rule.AlarmEnabled),
+			d.Set("alarm_action_enabled", rule.AlarmActionEnabled),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CES alarm template fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCesAlarmTemplateV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "policy") {
+		opts := getAlarmTemplateOpts(d)
+		log.Printf("[DEBUG] Update CES alarm template %s options: %#v", d.Id(), opts)
+		if err := UpdateAlarmTemplate(client, d.Id(), opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error updating CES alarm template %s: %s", d.Id(), err)
+		}
+	}
+
+	// alarm_targets is planned as computed, so the known value is the old one
+	oldTargets, _ := d.GetChange("alarm_targets")
+	targets := expandAlarmTemplateTargets(oldTargets)
+	recreate := d.HasChanges("name", "policy", "alarm_actions", "ok_actions", "alarm_enabled", "alarm_action_enabled")
+	err = syncAlarmTemplateRules(client, d, targets, recreate)
+	if setErr := setAlarmTemplateTargets(d, targets); setErr != nil {
+		return fmt.Errorf("error setting alarm_targets: %s", setErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceCesAlarmTemplateV1Read(d, meta)
+}
+
+func resourceCesAlarmTemplateV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	for _, alarmID := range expandAlarmTemplateTargets(d.Get("alarm_targets")) {
+		if err := deleteAlarmTemplateRule(client, alarmID); err != nil {
+			return err
+		}
+	}
+
+	if err := DeleteAlarmTemplate(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting CES alarm template")
+	}
+
+	d.SetId("")
+	return nil
+}
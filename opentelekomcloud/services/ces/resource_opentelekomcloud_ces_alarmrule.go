@@ -24,6 +24,10 @@ func ResourceAlarmRule() *schema.Resource {
 		Update: resourceAlarmRuleUpdate,
 		Delete: resourceAlarmRuleDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -61,7 +65,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"alarm_level": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      2,
 				ValidateFunc: validation.IntBetween(1, 4),
 			},
@@ -132,7 +135,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"condition": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -223,98 +225,11 @@ func ResourceAlarmRule() *schema.Resource {
 				},
 			},
 
-			"alarm_actions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								switch value {
-								case "notification":
-								case "autoscaling":
-								default:
-									errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
-								}
-								return
-							},
-						},
+			"alarm_actions": alarmActionsSchema(),
 
-						"notification_list": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 5,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
+			"insufficientdata_actions": alarmActionsSchema(),
 
-			"insufficientdata_actions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								switch value {
-								case "notification":
-								case "autoscaling":
-								default:
-									errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
-								}
-								return
-							},
-						},
-
-						"notification_list": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 5,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
-
-			"ok_actions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-								value := v.(string)
-								switch value {
-								case "notification":
-								case "autoscaling":
-								default:
-									errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
-								}
-								return
-							},
-						},
-
-						"notification_list": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 5,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
-			},
+			"ok_actions": alarmActionsSchema(),
 
 			"alarm_enabled": {
 				Type:     schema.TypeBool,
@@ -344,6 +259,39 @@ func ResourceAlarmRule() *schema.Resource {
 	}
 }
 
+// alarmActionsSchema is the schema of the actions triggered by the alarm state change
+func alarmActionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						value := v.(string)
+						switch value {
+						case "notification":
+						case "autoscaling":
+						default:
+							errors = append(errors, fmt.Errorf("%s can be notification or autoscaling", k))
+						}
+						return
+					},
+				},
+
+				"notification_list": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 5,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
 func getMetricOpts(d *schema.ResourceData) (alarmrule.MetricOpts, error) {
 	mos, ok := d.Get("metric").([]interface{})
 	if !ok {
@@ -367,6 +315,28 @@ func getMetricOpts(d *schema.ResourceData) (alarmrule.MetricOpts, error) {
 	}, nil
 }
 
+func getConditionOpts(d *schema.ResourceData) alarmrule.ConditionOpts {
+	cos := d.Get("condition").([]interface{})
+	co := cos[0].(map[string]interface{})
+	return alarmrule.ConditionOpts{
+		Period:             co["period"].(int),
+		Filter:             co["filter"].(string),
+		ComparisonOperator: co["comparison_operator"].(string),
+		Value:              co["value"].(int),
+		Unit:               co["unit"].(string),
+		Count:              co["count"].(int),
+	}
+}
+
+// getAlarmActionUpdate returns the non-nil actions list, so the removed actions are cleared on update
+func getAlarmActionUpdate(d *schema.ResourceData, name string) *[]alarmrule.ActionOpts {
+	actions := getAlarmAction(d, name)
+	if actions == nil {
+		actions = []alarmrule.ActionOpts{}
+	}
+	return &actions
+}
+
 func getAlarmAction(d *schema.ResourceData, name string) []alarmrule.ActionOpts {
 	aos := d.Get(name).([]interface{})
 	if len(aos) == 0 {
@@ -401,21 +371,12 @@ func resourceAlarmRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	cos := d.Get("condition").([]interface{})
-	co := cos[0].(map[string]interface{})
 	createOpts := alarmrule.CreateOpts{
-		AlarmName:        d.Get("alarm_name").(string),
-		AlarmDescription: d.Get("alarm_description").(string),
-		AlarmLevel:       d.Get("alarm_level").(int),
-		Metric:           metric,
-		Condition: alarmrule.ConditionOpts{
-			Period:             co["period"].(int),
-			Filter:             co["filter"].(string),
-			ComparisonOperator: co["comparison_operator"].(string),
-			Value:              co["value"].(int),
-			Unit:               co["unit"].(string),
-			Count:              co["count"].(int),
-		},
+		AlarmName:               d.Get("alarm_name").(string),
+		AlarmDescription:        d.Get("alarm_description").(string),
+		AlarmLevel:              d.Get("alarm_level").(int),
+		Metric:                  metric,
+		Condition:               getConditionOpts(d),
 		AlarmActions:            getAlarmAction(d, "alarm_actions"),
 		InsufficientdataActions: getAlarmAction(d, "insufficientdata_actions"),
 		OkActions:               getAlarmAction(d, "ok_actions"),
@@ -475,24 +436,47 @@ func resourceAlarmRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	arId := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
 
-	if !d.HasChange("alarm_enabled") {
-		log.Printf("[WARN] %s Nothing will be updated", nameCESAR)
-		return nil
+	if d.HasChanges("alarm_level", "condition", "alarm_actions", "insufficientdata_actions", "ok_actions") {
+		updateOpts := AlarmRuleUpdateOpts{
+			AlarmLevel:              d.Get("alarm_level").(int),
+			AlarmActions:            getAlarmActionUpdate(d, "alarm_actions"),
+			InsufficientdataActions: getAlarmActionUpdate(d, "insufficientdata_actions"),
+			OkActions:               getAlarmActionUpdate(d, "ok_actions"),
+		}
+		if d.HasChange("condition") {
+			condition := getConditionOpts(d)
+			updateOpts.Condition = &condition
+		}
+		log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAR, arId, updateOpts)
+
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err := UpdateAlarmRule(client, arId, updateOpts).ExtractErr()
+			if err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s %s: %s", nameCESAR, arId, err)
+		}
 	}
-	updateOpts := alarmrule.UpdateOpts{AlarmEnabled: d.Get("alarm_enabled").(bool)}
-	log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAR, arId, updateOpts)
 
-	timeout := d.Timeout(schema.TimeoutUpdate)
-	err = resource.Retry(timeout, func() *resource.RetryError {
-		err := alarmrule.Update(client, arId, updateOpts).ExtractErr()
+	if d.HasChange("alarm_enabled") {
+		updateOpts := alarmrule.UpdateOpts{AlarmEnabled: d.Get("alarm_enabled").(bool)}
+		log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAR, arId, updateOpts)
+
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err := alarmrule.Update(client, arId, updateOpts).ExtractErr()
+			if err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
 		if err != nil {
-			return common.CheckForRetryableError(err)
+			return fmt.Errorf("Error updating %s %s: %s", nameCESAR, arId, err)
 		}
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("Error updating %s %s: %s", nameCESAR, arId, err)
 	}
 
 	return resourceAlarmRuleRead(d, meta)
@@ -0,0 +1,334 @@
+package ces
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+var resourceGroupNameRegexp = regexp.MustCompile("^[a-zA-Z0-9_-]{1,128}$")
+
+// resourceGroupTagDimensions maps namespaces supporting tag-based selection
+// to the dimension identifying the instance
+var resourceGroupTagDimensions = map[string]string{
+	"SYS.ECS": "instance_id",
+}
+
+func ResourceCesResourceGroupV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCesResourceGroupV1Create,
+		Read:   resourceCesResourceGroupV1Read,
+		Update: resourceCesResourceGroupV1Update,
+		Delete: resourceCesResourceGroupV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resolveResourceGroupV1Members,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					resourceGroupNameRegexp,
+					"must be string of 1 to 128 characters that consists of letters, digits, hyphens(-) and underscores(_)",
+				),
+			},
+			"resources": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"resources", "tags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"resources", "tags"},
+				RequiredWith: []string{"namespace"},
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SYS.ECS"}, false),
+				RequiredWith: []string{"tags"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getResourceGroupResources(d *schema.ResourceData) []ResourceGroupResource {
+	return expandResourceGroupResources(d.Get("resources").([]interface{}))
+}
+
+func expandResourceGroupResources(rawResources []interface{}) []ResourceGroupResource {
+	resources := make([]ResourceGroupResource, len(rawResources))
+	for i, raw := range rawResources {
+		res := raw.(map[string]interface{})
+		rawDimensions := res["dimensions"].([]interface{})
+		dimensions := make([]ResourceGroupDimension, len(rawDimensions))
+		for j, rawDimension := range rawDimensions {
+			dimension := rawDimension.(map[string]interface{})
+			dimensions[j] = ResourceGroupDimension{
+				Name:  dimension["name"].(string),
+				Value: dimension["value"].(string),
+			}
+		}
+		resources[i] = ResourceGroupResource{
+			Namespace:  res["namespace"].(string),
+			Dimensions: dimensions,
+		}
+	}
+	return resources
+}
+
+func flattenResourceGroupResources(resources []ResourceGroupResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(resources))
+	for i, res := range resources {
+		dimensions := make([]map[string]interface{}, len(res.Dimensions))
+		for j, dimension := range res.Dimensions {
+			dimensions[j] = map[string]interface{}{
+				"name":  dimension.Name,
+				"value": dimension.Value,
+			}
+		}
+		result[i] = map[string]interface{}{
+			"namespace":  res.Namespace,
+			"dimensions": dimensions,
+		}
+	}
+	return result
+}
+
+// resourceGroupResourceKeys returns sorted `<namespace>/<name>=<value>` keys of the resources
+func resourceGroupResourceKeys(resources []ResourceGroupResource) []string {
+	keys := make([]string, len(resources))
+	for i, res := range resources {
+		dimensions := make([]string, len(res.Dimensions))
+		for j, dimension := range res.Dimensions {
+			dimensions[j] = fmt.Sprintf("%s=%s", dimension.Name, dimension.Value)
+		}
+		keys[i] = fmt.Sprintf("%s/%s", res.Namespace, strings.Join(dimensions, ","))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// listTaggedResources returns the resources of the namespace having all the tags
+func listTaggedResources(config *cfg.Config, region, namespace string, rawTags map[string]interface{}) ([]interface{}, error) {
+	client, err := config.ComputeV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud ComputeV1 client: %s", err)
+	}
+
+	tags := make(map[string]string)
+	for key, value := range rawTags {
+		tags[key] = value.(string)
+	}
+	serverIDs, err := ListServerIDsByTags(client, tags)
+	if err != nil {
+		return nil, fmt.Errorf("error listing ECS instances by tags: %s", err)
+	}
+	sort.Strings(serverIDs)
+
+	resources := make([]interface{}, len(serverIDs))
+	for i, serverID := range serverIDs {
+		resources[i] = map[string]interface{}{
+			"namespace": namespace,
+			"dimensions": []interface{}{
+				map[string]interface{}{
+					"name":  resourceGroupTagDimensions[namespace],
+					"value": serverID,
+				},
+			},
+		}
+	}
+	return resources, nil
+}
+
+// getResourceGroupOpts builds the group options, the resources selected by `tags` are resolved at the apply time
+func getResourceGroupOpts(d *schema.ResourceData, config *cfg.Config) (ResourceGroupOpts, error) {
+	opts := ResourceGroupOpts{
+		GroupName: d.Get("name").(string),
+		Resources: getResourceGroupResources(d),
+	}
+	rawTags := d.Get("tags").(map[string]interface{})
+	if len(rawTags) == 0 {
+		return opts, nil
+	}
+
+	namespace := d.Get("namespace").(string)
+	resources, err := listTaggedResources(config, config.GetRegion(d), namespace, rawTags)
+	if err != nil {
+		return opts, err
+	}
+	if len(resources) == 0 {
+		return opts, fmt.Errorf("no %s instances match the tags of CES resource group", namespace)
+	}
+	opts.Resources = expandResourceGroupResources(resources)
+	return opts, nil
+}
+
+// resolveResourceGroupV1Members plans the group resources as the instances currently
+// matching `tags`, so the group follows tagged instances being added and removed
+func resolveResourceGroupV1Members(d *schema.ResourceDiff, meta interface{}) error {
+	if len(d.Get("tags").(map[string]interface{})) == 0 && d.NewValueKnown("tags") {
+		return nil
+	}
+	if !d.NewValueKnown("tags") || !d.NewValueKnown("namespace") {
+		return d.SetNewComputed("resources")
+	}
+
+	config := meta.(*cfg.Config)
+	resources, err := listTaggedResources(config, config.GetRegion(d), d.Get("namespace").(string), d.Get("tags").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	// the instances can be created in the same run, they are resolved again on apply
+	if len(resources) == 0 {
+		return d.SetNewComputed("resources")
+	}
+
+	oldResources, _ := d.GetChange("resources")
+	oldKeys := resourceGroupResourceKeys(expandResourceGroupResources(oldResources.([]interface{})))
+	newKeys := resourceGroupResourceKeys(expandResourceGroupResources(resources))
+	if strings.Join(oldKeys, ";") == strings.Join(newKeys, ";") {
+		return nil
+	}
+	return d.SetNew("resources", resources)
+}
+
+func resourceCesResourceGroupV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	opts, err := getResourceGroupOpts(d, config)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Create CES resource group options: %#v", opts)
+
+	groupID, err := CreateResourceGroup(client, opts)
+	if err != nil {
+		return fmt.Errorf("error creating CES resource group: %s", err)
+	}
+	d.SetId(groupID)
+
+	return resourceCesResourceGroupV1Read(d, meta)
+}
+
+func resourceCesResourceGroupV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	group, err := GetResourceGroup(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error getting CES resource group")
+	}
+	log.Printf("[DEBUG] Retrieved CES resource group %s: %#v", d.Id(), group)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", group.GroupName),
+		d.Set("resources", flattenResourceGroupResources(group.Resources)),
+		d.Set("status", group.Status),
+		d.Set("create_time", group.CreateTime),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CES resource group fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCesResourceGroupV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	opts, err := getResourceGroupOpts(d, config)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Update CES resource group %s options: %#v", d.Id(), opts)
+
+	if err := UpdateResourceGroup(client, d.Id(), opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error updating CES resource group %s: %s", d.Id(), err)
+	}
+
+	return resourceCesResourceGroupV1Read(d, meta)
+}
+
+func resourceCesResourceGroupV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	if err := DeleteResourceGroup(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting CES resource group")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,362 @@
+package ces
+
+import (
+	"strconv"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metricdata"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cloudeyeservice/alarmrule"
+)
+
+// ResourceGroupDimension represents the dimension identifying the resource in the CES resource group.
+type ResourceGroupDimension struct {
+	Name  string `json:"name" required:"true"`
+	Value string `json:"value" required:"true"`
+}
+
+// ResourceGroupResource represents the single resource of the CES resource group.
+type ResourceGroupResource struct {
+	Namespace  string                   `json:"namespace" required:"true"`
+	Dimensions []ResourceGroupDimension `json:"dimensions" required:"true"`
+}
+
+// ResourceGroupOpts represents the attributes used when creating or updating the CES resource group.
+type ResourceGroupOpts struct {
+	GroupName string                  `json:"group_name" required:"true"`
+	Resources []ResourceGroupResource `json:"resources" required:"true"`
+}
+
+// ToResourceGroupMap builds a request body from ResourceGroupOpts.
+func (opts ResourceGroupOpts) ToResourceGroupMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ResourceGroup represents the CES resource group.
+type ResourceGroup struct {
+	GroupID    string                  `json:"group_id"`
+	GroupName  string                  `json:"group_name"`
+	CreateTime int64                   `json:"create_time"`
+	Status     string                  `json:"status"`
+	Resources  []ResourceGroupResource `json:"resources"`
+}
+
+// CreateResourceGroup creates a new CES resource group and returns its ID.
+func CreateResourceGroup(client *golangsdk.ServiceClient, opts ResourceGroupOpts) (string, error) {
+	b, err := opts.ToResourceGroupMap()
+	if err != nil {
+		return "", err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("resource-groups"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+
+	var s struct {
+		GroupID string `json:"group_id"`
+	}
+	err = r.ExtractInto(&s)
+	return s.GroupID, err
+}
+
+// GetResourceGroup retrieves the CES resource group details.
+func GetResourceGroup(client *golangsdk.ServiceClient, groupID string) (*ResourceGroup, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("resource-groups", groupID), &r.Body, nil)
+
+	var s ResourceGroup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpdateResourceGroup replaces the name and the resources of the CES resource group.
+func UpdateResourceGroup(client *golangsdk.ServiceClient, groupID string, opts ResourceGroupOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToResourceGroupMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("resource-groups", groupID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// DeleteResourceGroup deletes the CES resource group.
+func DeleteResourceGroup(client *golangsdk.ServiceClient, groupID string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("resource-groups", groupID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// AlarmTemplatePolicy represents the single alarm policy of the CES alarm template.
+type AlarmTemplatePolicy struct {
+	Namespace          string `json:"namespace" required:"true"`
+	DimensionName      string `json:"dimension_name" required:"true"`
+	MetricName         string `json:"metric_name" required:"true"`
+	Period             int    `json:"period" required:"true"`
+	Filter             string `json:"filter" required:"true"`
+	ComparisonOperator string `json:"comparison_operator" required:"true"`
+	Value              int    `json:"value"`
+	Unit               string `json:"unit,omitempty"`
+	Count              int    `json:"count" required:"true"`
+	AlarmLevel         int    `json:"alarm_level,omitempty"`
+}
+
+// AlarmTemplateOpts represents the attributes used when creating or updating the CES alarm template.
+type AlarmTemplateOpts struct {
+	TemplateName        string                `json:"template_name" required:"true"`
+	TemplateDescription string                `json:"template_description,omitempty"`
+	Policies            []AlarmTemplatePolicy `json:"policies" required:"true"`
+}
+
+// ToAlarmTemplateMap builds a request body from AlarmTemplateOpts.
+func (opts AlarmTemplateOpts) ToAlarmTemplateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// AlarmTemplate represents the CES alarm template.
+type AlarmTemplate struct {
+	TemplateID          string                `json:"template_id"`
+	TemplateName        string                `json:"template_name"`
+	TemplateDescription string                `json:"template_description"`
+	Policies            []AlarmTemplatePolicy `json:"policies"`
+}
+
+// CreateAlarmTemplate creates a new CES alarm template and returns its ID.
+func CreateAlarmTemplate(client *golangsdk.ServiceClient, opts AlarmTemplateOpts) (string, error) {
+	b, err := opts.ToAlarmTemplateMap()
+	if err != nil {
+		return "", err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("alarm-template"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+
+	var s struct {
+		TemplateID string `json:"template_id"`
+	}
+	err = r.ExtractInto(&s)
+	return s.TemplateID, err
+}
+
+// GetAlarmTemplate retrieves the CES alarm template details.
+func GetAlarmTemplate(client *golangsdk.ServiceClient, templateID string) (*AlarmTemplate, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("alarm-template", templateID), &r.Body, nil)
+
+	var s AlarmTemplate
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpdateAlarmTemplate replaces the name, the description and the policies of the CES alarm template.
+func UpdateAlarmTemplate(client *golangsdk.ServiceClient, templateID string, opts AlarmTemplateOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToAlarmTemplateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("alarm-template", templateID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// DeleteAlarmTemplate deletes the CES alarm template.
+func DeleteAlarmTemplate(client *golangsdk.ServiceClient, templateID string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("alarm-template", templateID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// MetricDatapoint represents the aggregated value of the metric in a single period.
+// Only the value of the requested filter is set.
+type MetricDatapoint struct {
+	Average   float64 `json:"average"`
+	Max       float64 `json:"max"`
+	Min       float64 `json:"min"`
+	Sum       float64 `json:"sum"`
+	Variance  float64 `json:"variance"`
+	Timestamp int64   `json:"timestamp"`
+	Unit      string  `json:"unit"`
+}
+
+// MetricData represents the data points of the CES metric.
+type MetricData struct {
+	MetricName string            `json:"metric_name"`
+	Datapoints []MetricDatapoint `json:"datapoints"`
+}
+
+// GetMetricData retrieves the metric data points aggregated by the given filter and period.
+func GetMetricData(client *golangsdk.ServiceClient, opts metricdata.GetOpts) (*MetricData, error) {
+	q, err := golangsdk.BuildQueryString(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("metric-data")+q.String(), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	var s MetricData
+	err = r.ExtractInto(&s)
+	return &s, err
+}
+
+// AlarmRuleUpdateOpts represents the attributes used when modifying the CES alarm rule.
+// The SDK alarmrule.UpdateOpts supports enabling and disabling the alarm only.
+type AlarmRuleUpdateOpts struct {
+	AlarmLevel              int                      `json:"alarm_level,omitempty"`
+	Condition               *alarmrule.ConditionOpts `json:"condition,omitempty"`
+	AlarmActions            *[]alarmrule.ActionOpts  `json:"alarm_actions,omitempty"`
+	InsufficientdataActions *[]alarmrule.ActionOpts  `json:"insufficientdata_actions,omitempty"`
+	OkActions               *[]alarmrule.ActionOpts  `json:"ok_actions,omitempty"`
+}
+
+// ToAlarmRuleUpdateMap builds a request body from AlarmRuleUpdateOpts.
+func (opts AlarmRuleUpdateOpts) ToAlarmRuleUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateAlarmRule modifies the condition, the level and the actions of the CES alarm rule.
+func UpdateAlarmRule(client *golangsdk.ServiceClient, alarmID string, opts AlarmRuleUpdateOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToAlarmRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("alarms", alarmID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// AlarmRuleDimension represents the metric dimension of the listed CES alarm rule.
+type AlarmRuleDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AlarmRuleSummary represents the CES alarm rule returned by ListAlarmRules.
+type AlarmRuleSummary struct {
+	AlarmID          string `json:"alarm_id"`
+	AlarmName        string `json:"alarm_name"`
+	AlarmDescription string `json:"alarm_description"`
+	Metric           struct {
+		Namespace  string               `json:"namespace"`
+		MetricName string               `json:"metric_name"`
+		Dimensions []AlarmRuleDimension `json:"dimensions"`
+	} `json:"metric"`
+	Condition struct {
+		Period             int     `json:"period"`
+		Filter             string  `json:"filter"`
+		ComparisonOperator string  `json:"comparison_operator"`
+		Value              float64 `json:"value"`
+		Count              int     `json:"count"`
+	} `json:"condition"`
+	AlarmActions       []alarmrule.ActionInfo `json:"alarm_actions"`
+	OkActions          []alarmrule.ActionInfo `json:"ok_actions"`
+	AlarmEnabled       bool                   `json:"alarm_enabled"`
+	AlarmActionEnabled bool                   `json:"alarm_action_enabled"`
+}
+
+const alarmRulesPageLimit = 100
+
+// ListAlarmRules retrieves all CES alarm rules of the project following the `start` marker.
+func ListAlarmRules(client *golangsdk.ServiceClient) ([]AlarmRuleSummary, error) {
+	var rules []AlarmRuleSummary
+	marker := ""
+	for {
+		url := client.ServiceURL("alarms") + "?limit=" + strconv.Itoa(alarmRulesPageLimit)
+		if marker != "" {
+			url += "&start=" + marker
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(url, &r.Body, nil)
+
+		var s struct {
+			MetricAlarms []AlarmRuleSummary `json:"metric_alarms"`
+			MetaData     struct {
+				Marker string `json:"marker"`
+			} `json:"meta_data"`
+		}
+		if err := r.ExtractInto(&s); err != nil {
+			return nil, err
+		}
+		rules = append(rules, s.MetricAlarms...)
+		if len(s.MetricAlarms) < alarmRulesPageLimit || s.MetaData.Marker == "" {
+			return rules, nil
+		}
+		marker = s.MetaData.Marker
+	}
+}
+
+// ServerTagFilter represents the tag used to filter ECS instances, all the values match the tag.
+type ServerTagFilter struct {
+	Key    string   `json:"key" required:"true"`
+	Values []string `json:"values" required:"true"`
+}
+
+// ServerFilterOpts represents the options used for filtering ECS instances by tags.
+// Limit and offset are strings in the ECS API.
+type ServerFilterOpts struct {
+	Action string            `json:"action" required:"true"`
+	Tags   []ServerTagFilter `json:"tags" required:"true"`
+	Limit  string            `json:"limit,omitempty"`
+	Offset string            `json:"offset,omitempty"`
+}
+
+// ToServerFilterMap builds a request body from ServerFilterOpts.
+func (opts ServerFilterOpts) ToServerFilterMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+const serversPageLimit = 1000
+
+// ListServerIDsByTags returns IDs of the ECS instances having all the given tags.
+// The client must be an ECS v1 client.
+func ListServerIDsByTags(client *golangsdk.ServiceClient, tags map[string]string) ([]string, error) {
+	opts := ServerFilterOpts{
+		Action: "filter",
+		Limit:  strconv.Itoa(serversPageLimit),
+	}
+	for key, value := range tags {
+		opts.Tags = append(opts.Tags, ServerTagFilter{Key: key, Values: []string{value}})
+	}
+
+	var ids []string
+	for offset := 0; ; offset += serversPageLimit {
+		opts.Offset = strconv.Itoa(offset)
+		b, err := opts.ToServerFilterMap()
+		if err != nil {
+			return nil, err
+		}
+
+		var r golangsdk.Result
+		_, r.Err = client.Post(client.ServiceURL("cloudservers", "resource_instances", "action"), b, &r.Body, &golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+
+		var s struct {
+			Resources []struct {
+				ResourceID string `json:"resource_id"`
+			} `json:"resources"`
+			TotalCount int `json:"total_count"`
+		}
+		if err := r.ExtractInto(&s); err != nil {
+			return nil, err
+		}
+		for _, res := range s.Resources {
+			ids = append(ids, res.ResourceID)
+		}
+		if len(s.Resources) < serversPageLimit || len(ids) >= s.TotalCount {
+			return ids, nil
+		}
+	}
+}
@@ -3,11 +3,13 @@ package css
 import (
 	"fmt"
 	"log"
-	"reflect"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/snapshots"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -20,6 +22,10 @@ func ResourceCssClusterV1() *schema.Resource {
 		Update: resourceCssClusterV1Update,
 		Delete: resourceCssClusterV1Delete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -98,6 +104,7 @@ func ResourceCssClusterV1() *schema.Resource {
 						"availability_zone": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ForceNew: true,
 						},
 					},
@@ -117,6 +124,84 @@ func ResourceCssClusterV1() *schema.Resource {
 				Default:  1,
 			},
 
+			"security_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"admin_pass": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"backup_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keep_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntBetween(1, 90),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "snapshot",
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"agency": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"base_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "css_repository",
+						},
+					},
+				},
+			},
+
+			"kibana_public_access": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bandwidth": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"whitelist_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"whitelist": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,99 +259,92 @@ func ResourceCssClusterV1() *schema.Resource {
 	}
 }
 
-func resourceCssClusterV1UserInputParams(d *schema.ResourceData) map[string]interface{} {
-	return map[string]interface{}{
-		"terraform_resource_data": d,
-		"enable_https":            d.Get("enable_https"),
-		"expect_node_num":         d.Get("expect_node_num"),
-		"name":                    d.Get("name"),
-		"node_config":             d.Get("node_config"),
-	}
-}
-
-func resourceCssClusterV1Create(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*cfg.Config)
-	client, err := config.CssV1Client(config.GetRegion(d))
-	if err != nil {
-		return fmt.Errorf("error creating sdk client, err=%s", err)
-	}
-
-	opts := resourceCssClusterV1UserInputParams(d)
+func resourceCssClusterV1NodeConfig(d *schema.ResourceData) InstanceSpec {
+	nodeConfig := d.Get("node_config.0").(map[string]interface{})
+	networkInfo := nodeConfig["network_info"].([]interface{})[0].(map[string]interface{})
+	volume := nodeConfig["volume"].([]interface{})[0].(map[string]interface{})
 
-	arrayIndex := map[string]int{
-		"node_config.network_info": 0,
-		"node_config.volume":       0,
-		"node_config":              0,
+	return InstanceSpec{
+		FlavorRef: nodeConfig["flavor"].(string),
+		Volume: VolumeSpec{
+			VolumeType: volume["volume_type"].(string),
+			Size:       volume["size"].(int),
+		},
+		Nics: Nics{
+			VpcID:           networkInfo["vpc_id"].(string),
+			NetID:           networkInfo["network_id"].(string),
+			SecurityGroupID: networkInfo["security_group_id"].(string),
+		},
+		AvailabilityZone: nodeConfig["availability_zone"].(string),
 	}
+}
 
-	params, err := buildCssClusterV1CreateParameters(opts, arrayIndex)
-	if err != nil {
-		return fmt.Errorf("error building the request body of api(create)")
+func resourceCssClusterV1DiskEncryption(d *schema.ResourceData) *DiskEncryption {
+	encryptionKey := d.Get("node_config.0.volume.0.encryption_key").(string)
+	if encryptionKey == "" {
+		return &DiskEncryption{SystemEncrypted: "0"}
 	}
-	r, err := sendCssClusterV1CreateRequest(d, params, client)
-	if err != nil {
-		return fmt.Errorf("error creating CssClusterV1: %s", err)
+	return &DiskEncryption{
+		SystemEncrypted: "1",
+		SystemCmkID:     encryptionKey,
 	}
+}
 
-	timeout := d.Timeout(schema.TimeoutCreate)
-	obj, err := asyncWaitCssClusterV1Create(d, config, r, client, timeout)
-	if err != nil {
-		return err
-	}
-	id, err := common.NavigateValue(obj, []string{"id"}, nil)
-	if err != nil {
-		return fmt.Errorf("error constructing id: %s", err)
+func resourceCssClusterV1PublicKibana(d *schema.ResourceData) *PublicKibanaOpts {
+	accessRaw := d.Get("kibana_public_access").([]interface{})
+	if len(accessRaw) == 0 {
+		return nil
+	}
+	access := accessRaw[0].(map[string]interface{})
+	return &PublicKibanaOpts{
+		EipSize: access["bandwidth"].(int),
+		WhiteList: &PublicKibanaWhitelist{
+			Enable:    access["whitelist_enabled"].(bool),
+			WhiteList: access["whitelist"].(string),
+		},
 	}
-	d.SetId(id.(string))
-
-	return resourceCssClusterV1Read(d, meta)
 }
 
-func resourceCssClusterV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceCssClusterV1Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.CssV1Client(config.GetRegion(d))
 	if err != nil {
-		return fmt.Errorf("error creating sdk client, err=%s", err)
+		return fmt.Errorf("error creating CSSv1 client: %s", err)
 	}
 
-	res := make(map[string]interface{})
-
-	v, err := sendCssClusterV1ReadRequest(d, client)
-	if err != nil {
+	if err := validateCssClusterV1SecurityMode(d); err != nil {
 		return err
 	}
-	res["read"] = v
 
-	return setCssClusterV1Properties(d, res)
-}
+	createOpts := ClusterCreateOpts{
+		Name:           d.Get("name").(string),
+		InstanceNum:    d.Get("expect_node_num").(int),
+		Instance:       resourceCssClusterV1NodeConfig(d),
+		HttpsEnable:    "false",
+		DiskEncryption: resourceCssClusterV1DiskEncryption(d),
+		PublicKibana:   resourceCssClusterV1PublicKibana(d),
+	}
+	if d.Get("enable_https").(bool) {
+		createOpts.HttpsEnable = "true"
+	}
+	if d.Get("security_mode").(bool) {
+		createOpts.AuthorityEnable = true
+		createOpts.AdminPassword = d.Get("admin_pass").(string)
+	}
 
-func resourceCssClusterV1Update(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*cfg.Config)
-	client, err := config.CssV1Client(config.GetRegion(d))
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	cluster, err := CreateCluster(client, createOpts)
 	if err != nil {
-		return fmt.Errorf("error creating sdk client, err=%s", err)
+		return fmt.Errorf("error creating CSS cluster: %s", err)
 	}
+	d.SetId(cluster.ID)
 
-	opts := resourceCssClusterV1UserInputParams(d)
-
-	arrayIndex := map[string]int{
-		"node_config.network_info": 0,
-		"node_config.volume":       0,
-		"node_config":              0,
+	if _, err := asyncWaitCssClusterV1Available(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CSS cluster %s to become available: %s", d.Id(), err)
 	}
-	timeout := d.Timeout(schema.TimeoutUpdate)
 
-	params, err := buildCssClusterV1ExtendClusterParameters(opts, arrayIndex)
-	if err != nil {
-		return fmt.Errorf("error building the request body of api(extend_cluster)")
-	}
-	if e, _ := common.IsEmptyValue(reflect.ValueOf(params)); !e {
-		r, err := sendCssClusterV1ExtendClusterRequest(d, params, client)
-		if err != nil {
-			return err
-		}
-		_, err = asyncWaitCssClusterV1ExtendCluster(d, config, r, client, timeout)
-		if err != nil {
+	if _, ok := d.GetOk("backup_strategy"); ok {
+		if err := updateCssClusterV1BackupStrategy(d, client); err != nil {
 			return err
 		}
 	}
@@ -274,619 +352,331 @@ func resourceCssClusterV1Update(d *schema.ResourceData, meta interface{}) error
 	return resourceCssClusterV1Read(d, meta)
 }
 
-func resourceCssClusterV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceCssClusterV1Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.CssV1Client(config.GetRegion(d))
 	if err != nil {
-		return fmt.Errorf("error creating sdk client, err=%s", err)
+		return fmt.Errorf("error creating CSSv1 client: %s", err)
 	}
 
-	url, err := common.ReplaceVars(d, "clusters/{id}", nil)
+	cluster, err := GetCluster(client, d.Id())
 	if err != nil {
-		return err
-	}
-	url = client.ServiceURL(url)
-
-	log.Printf("[DEBUG] Deleting Cluster %q", d.Id())
-	r := golangsdk.Result{}
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
-		OkCodes:      common.SuccessHTTPCodes,
-		JSONBody:     nil,
-		JSONResponse: nil,
-		MoreHeaders:  map[string]string{"Content-Type": "application/json"},
-	})
-	if r.Err != nil {
-		return fmt.Errorf("error deleting Cluster %q: %s", d.Id(), r.Err)
+		return common.CheckDeleted(d, err, "error reading CSS cluster")
 	}
+	log.Printf("[DEBUG] Retrieved CSS cluster %s: %#v", d.Id(), cluster)
 
-	_, err = common.WaitToFinish(
-		[]string{"Done"}, []string{"Pending"},
-		d.Timeout(schema.TimeoutCreate),
-		1*time.Second,
-		func() (interface{}, string, error) {
-			_, err := client.Get(url, nil, &golangsdk.RequestOpts{
-				MoreHeaders: map[string]string{"Content-Type": "application/json"}})
-			if err != nil {
-				if _, ok := err.(golangsdk.ErrDefault404); ok {
-					return true, "Done", nil
-				}
-				return nil, "", nil
-			}
-			return true, "Pending", nil
-		},
+	mErr := multierror.Append(nil,
+		d.Set("name", cluster.Name),
+		d.Set("created", cluster.Created),
+		d.Set("updated", cluster.Updated),
+		d.Set("endpoint", cluster.Endpoint),
+		d.Set("enable_https", cluster.HttpsEnable),
+		d.Set("security_mode", cluster.AuthorityEnable),
+		d.Set("expect_node_num", len(cluster.Instances)),
+		d.Set("datastore", []interface{}{map[string]interface{}{
+			"type":    cluster.Datastore.Type,
+			"version": cluster.Datastore.Version,
+		}}),
+		d.Set("node_config", flattenCssClusterV1NodeConfig(cluster)),
+		d.Set("nodes", flattenCssClusterV1Nodes(cluster)),
+		d.Set("kibana_public_access", flattenCssClusterV1PublicKibana(cluster)),
 	)
-	return err
-}
-
-func buildCssClusterV1CreateParameters(opts map[string]interface{}, arrayIndex map[string]int) (interface{}, error) {
-	params := make(map[string]interface{})
-
-	v, err := expandCssClusterV1CreateDiskEncryption(opts, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		params["diskEncryption"] = v
-	}
-
-	v, err = expandCssClusterV1CreateHttpsEnable(opts, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		params["httpsEnable"] = v
-	}
-
-	v, err = expandCssClusterV1CreateInstance(opts, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		params["instance"] = v
-	}
-
-	v, err = common.NavigateValue(opts, []string{"expect_node_num"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		params["instanceNum"] = v
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CSS cluster fields: %s", err)
 	}
 
-	v, err = common.NavigateValue(opts, []string{"name"}, arrayIndex)
+	policy, err := snapshots.PolicyGet(client, d.Id()).Extract()
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("error reading CSS cluster snapshot policy: %s", err)
 	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		params["name"] = v
+	var backupStrategy []interface{}
+	if policy.Enable == "true" {
+		backupStrategy = []interface{}{map[string]interface{}{
+			"start_time": policy.Period,
+			"keep_days":  policy.KeepDay,
+			"prefix":     policy.Prefix,
+			"bucket":     policy.Bucket,
+			"agency":     policy.Agency,
+			"base_path":  policy.BasePath,
+		}}
 	}
-
-	if len(params) == 0 {
-		return params, nil
+	if err := d.Set("backup_strategy", backupStrategy); err != nil {
+		return fmt.Errorf("error setting CSS cluster backup_strategy: %s", err)
 	}
 
-	params = map[string]interface{}{"cluster": params}
-
-	return params, nil
+	return nil
 }
 
-func expandCssClusterV1CreateDiskEncryption(d interface{}, arrayIndex map[string]int) (interface{}, error) {
-	req := make(map[string]interface{})
-
-	v, err := common.NavigateValue(d, []string{"node_config", "volume", "encryption_key"}, arrayIndex)
-	if err != nil {
-		return nil, err
+func flattenCssClusterV1NodeConfig(cluster *Cluster) []interface{} {
+	nodeConfig := map[string]interface{}{
+		"network_info": []interface{}{map[string]interface{}{
+			"network_id":        cluster.SubnetID,
+			"security_group_id": cluster.SecurityGroupID,
+			"vpc_id":            cluster.VpcID,
+		}},
 	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["systemCmkid"] = v
+	volume := map[string]interface{}{
+		"encryption_key": cluster.CmkID,
 	}
-
-	v, err = expandCssClusterV1CreateDiskEncryptionSystemEncrypted(d, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["systemEncrypted"] = v
+	if len(cluster.Instances) > 0 {
+		instance := cluster.Instances[0]
+		nodeConfig["flavor"] = instance.SpecCode
+		nodeConfig["availability_zone"] = instance.AzCode
+		volume["volume_type"] = instance.Volume.Type
+		volume["size"] = instance.Volume.Size
 	}
+	nodeConfig["volume"] = []interface{}{volume}
 
-	return req, nil
+	return []interface{}{nodeConfig}
 }
 
-func expandCssClusterV1CreateDiskEncryptionSystemEncrypted(d interface{}, arrayIndex map[string]int) (interface{}, error) {
-	v, err := common.NavigateValue(d, []string{"node_config", "volume", "encryption_key"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if v1, ok := v.(string); ok && v1 != "" {
-		return "1", nil
+func flattenCssClusterV1Nodes(cluster *Cluster) []interface{} {
+	nodes := make([]interface{}, len(cluster.Instances))
+	for i, instance := range cluster.Instances {
+		nodes[i] = map[string]interface{}{
+			"id":   instance.ID,
+			"name": instance.Name,
+			"type": instance.Type,
+		}
 	}
-	return "0", nil
+	return nodes
 }
 
-func expandCssClusterV1CreateHttpsEnable(d interface{}, arrayIndex map[string]int) (interface{}, error) {
-	v, err := common.NavigateValue(d, []string{"enable_https"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if v1, ok := v.(bool); ok && v1 {
-		return "true", nil
+func flattenCssClusterV1PublicKibana(cluster *Cluster) []interface{} {
+	if cluster.PublicKibana == nil || cluster.PublicKibana.EipSize == 0 {
+		return nil
 	}
-	return "false", nil
+	return []interface{}{map[string]interface{}{
+		"bandwidth":         cluster.PublicKibana.EipSize,
+		"whitelist_enabled": cluster.PublicKibana.WhiteList.Enable,
+		"whitelist":         cluster.PublicKibana.WhiteList.WhiteList,
+		"public_ip":         cluster.PublicKibana.PublicKibanaIP,
+	}}
 }
 
-func expandCssClusterV1CreateInstance(d interface{}, arrayIndex map[string]int) (interface{}, error) {
-	req := make(map[string]interface{})
-
-	v, err := common.NavigateValue(d, []string{"node_config", "availability_zone"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["availability_zone"] = v
-	}
-
-	v, err = common.NavigateValue(d, []string{"node_config", "flavor"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["flavorRef"] = v
-	}
-
-	v, err = expandCssClusterV1CreateInstanceNics(d, arrayIndex)
+func resourceCssClusterV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
 	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["nics"] = v
+		return fmt.Errorf("error creating CSSv1 client: %s", err)
 	}
 
-	v, err = expandCssClusterV1CreateInstanceVolume(d, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["volume"] = v
+	if d.HasChange("expect_node_num") {
+		oldNum, newNum := d.GetChange("expect_node_num")
+		size := newNum.(int) - oldNum.(int)
+		if size < 0 {
+			return fmt.Errorf("it only supports extending nodes")
+		}
+		if err := ExtendCluster(client, d.Id(), size).ExtractErr(); err != nil {
+			return fmt.Errorf("error extending CSS cluster: %s", err)
+		}
+		if _, err := asyncWaitCssClusterV1ExtendCluster(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
 
-	return req, nil
-}
-
-func expandCssClusterV1CreateInstanceNics(d interface{}, arrayIndex map[string]int) (interface{}, error) {
-	req := make(map[string]interface{})
-
-	v, err := common.NavigateValue(d, []string{"node_config", "network_info", "network_id"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["netId"] = v
+	if d.HasChanges("security_mode", "admin_pass") {
+		if err := updateCssClusterV1SecurityMode(d, client); err != nil {
+			return err
+		}
 	}
 
-	v, err = common.NavigateValue(d, []string{"node_config", "network_info", "security_group_id"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["securityGroupId"] = v
+	if d.HasChange("backup_strategy") {
+		if err := updateCssClusterV1BackupStrategy(d, client); err != nil {
+			return err
+		}
 	}
 
-	v, err = common.NavigateValue(d, []string{"node_config", "network_info", "vpc_id"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["vpcId"] = v
+	if d.HasChange("kibana_public_access") {
+		if err := updateCssClusterV1PublicKibana(d, client); err != nil {
+			return err
+		}
 	}
 
-	return req, nil
+	return resourceCssClusterV1Read(d, meta)
 }
 
-func expandCssClusterV1CreateInstanceVolume(d interface{}, arrayIndex map[string]int) (interface{}, error) {
-	req := make(map[string]interface{})
-
-	v, err := common.NavigateValue(d, []string{"node_config", "volume", "size"}, arrayIndex)
+func resourceCssClusterV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
 	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["size"] = v
+		return fmt.Errorf("error creating CSSv1 client: %s", err)
 	}
 
-	v, err = common.NavigateValue(d, []string{"node_config", "volume", "volume_type"}, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		req["volume_type"] = v
+	log.Printf("[DEBUG] Deleting CSS cluster %q", d.Id())
+	if err := DeleteCluster(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting CSS cluster")
 	}
 
-	return req, nil
-}
-
-func sendCssClusterV1CreateRequest(_ *schema.ResourceData, params interface{},
-	client *golangsdk.ServiceClient) (interface{}, error) {
-	url := client.ServiceURL("clusters")
-
-	r := golangsdk.Result{}
-	_, r.Err = client.Post(url, params, &r.Body, &golangsdk.RequestOpts{
-		OkCodes: common.SuccessHTTPCodes,
-	})
-	if r.Err != nil {
-		return nil, fmt.Errorf("error running api(create): %s", r.Err)
-	}
-	return r.Body, nil
+	_, err = common.WaitToFinish(
+		[]string{"Done"}, []string{"Pending"},
+		d.Timeout(schema.TimeoutCreate),
+		1*time.Second,
+		func() (interface{}, string, error) {
+			cluster, err := GetCluster(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return true, "Done", nil
+				}
+				return nil, "", nil
+			}
+			return cluster, "Pending", nil
+		},
+	)
+	return err
 }
 
-func asyncWaitCssClusterV1Create(d *schema.ResourceData, _ *cfg.Config, result interface{},
-	client *golangsdk.ServiceClient, timeout time.Duration) (interface{}, error) {
-
-	data := make(map[string]string)
-	pathParameters := map[string][]string{
-		"id": {"cluster", "id"},
-	}
-	for key, path := range pathParameters {
-		value, err := common.NavigateValue(result, path, nil)
-		if err != nil {
-			return nil, fmt.Errorf("error retrieving async operation path parameter: %s", err)
-		}
-		data[key] = value.(string)
-	}
-
-	url, err := common.ReplaceVars(d, "clusters/{id}", data)
-	if err != nil {
-		return nil, err
-	}
-	url = client.ServiceURL(url)
-
+func asyncWaitCssClusterV1ExtendCluster(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) (interface{}, error) {
 	return common.WaitToFinish(
-		[]string{"200"},
-		[]string{"100"},
-		timeout, 1*time.Second,
+		[]string{"Done"}, []string{"Pending"}, timeout, 1*time.Second,
 		func() (interface{}, string, error) {
-			r := golangsdk.Result{}
-			_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
-				MoreHeaders: map[string]string{"Content-Type": "application/json"}})
-			if r.Err != nil {
+			cluster, err := GetCluster(client, d.Id())
+			if err != nil {
 				return nil, "", nil
 			}
 
-			status, err := common.NavigateValue(r.Body, []string{"status"}, nil)
-			if err != nil {
-				return nil, "", nil
+			if checkCssClusterV1ExtendClusterFinished(cluster) {
+				return cluster, "Done", nil
 			}
-			return r.Body, status.(string), nil
+			return cluster, "Pending", nil
 		},
 	)
 }
 
-func buildCssClusterV1ExtendClusterParameters(opts map[string]interface{}, arrayIndex map[string]int) (interface{}, error) {
-	params := make(map[string]interface{})
-
-	v, err := expandCssClusterV1ExtendClusterNodeNum(opts, arrayIndex)
-	if err != nil {
-		return nil, err
-	}
-	if e, err := common.IsEmptyValue(reflect.ValueOf(v)); err != nil {
-		return nil, err
-	} else if !e {
-		params["modifySize"] = v
-	}
-
-	if len(params) == 0 {
-		return params, nil
-	}
-
-	params = map[string]interface{}{"grow": params}
-
-	return params, nil
-}
-
-func sendCssClusterV1ExtendClusterRequest(d *schema.ResourceData, params interface{},
-	client *golangsdk.ServiceClient) (interface{}, error) {
-	url, err := common.ReplaceVars(d, "clusters/{id}/extend", nil)
-	if err != nil {
-		return nil, err
-	}
-	url = client.ServiceURL(url)
-
-	r := golangsdk.Result{}
-	_, r.Err = client.Post(url, params, &r.Body, &golangsdk.RequestOpts{
-		OkCodes: common.SuccessHTTPCodes,
-	})
-	if r.Err != nil {
-		return nil, fmt.Errorf("error running api(extend_cluster): %s", r.Err)
-	}
-	return r.Body, nil
-}
-
-func asyncWaitCssClusterV1ExtendCluster(d *schema.ResourceData, _ *cfg.Config, _ interface{}, client *golangsdk.ServiceClient, timeout time.Duration) (interface{}, error) {
-
-	url, err := common.ReplaceVars(d, "clusters/{id}", nil)
-	if err != nil {
-		return nil, err
-	}
-	url = client.ServiceURL(url)
-
+func asyncWaitCssClusterV1Available(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) (interface{}, error) {
 	return common.WaitToFinish(
 		[]string{"Done"}, []string{"Pending"}, timeout, 1*time.Second,
 		func() (interface{}, string, error) {
-			r := golangsdk.Result{}
-			_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
-				MoreHeaders: map[string]string{"Content-Type": "application/json"}})
-			if r.Err != nil {
+			cluster, err := GetCluster(client, d.Id())
+			if err != nil {
 				return nil, "", nil
 			}
 
-			if checkCssClusterV1ExtendClusterFinished(r.Body) {
-				return r.Body, "Done", nil
+			switch cluster.Status {
+			case "200":
+				return cluster, "Done", nil
+			case "303":
+				return cluster, "", fmt.Errorf("CSS cluster %s is unavailable", d.Id())
 			}
-			return r.Body, "Pending", nil
+			return cluster, "Pending", nil
 		},
 	)
 }
 
-func sendCssClusterV1ReadRequest(d *schema.ResourceData, client *golangsdk.ServiceClient) (interface{}, error) {
-	url, err := common.ReplaceVars(d, "clusters/{id}", nil)
-	if err != nil {
-		return nil, err
-	}
-	url = client.ServiceURL(url)
-
-	r := golangsdk.Result{}
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
-		MoreHeaders: map[string]string{"Content-Type": "application/json"}})
-	if r.Err != nil {
-		return nil, fmt.Errorf("error running api(read) for resource(CssClusterV1), error: %s", r.Err)
-	}
-
-	return r.Body, nil
-}
-
-func setCssClusterV1Properties(d *schema.ResourceData, response map[string]interface{}) error {
-	opts := resourceCssClusterV1UserInputParams(d)
-
-	v, err := common.NavigateValue(response, []string{"read", "created"}, nil)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:created, err: %s", err)
+func validateCssClusterV1SecurityMode(d *schema.ResourceData) error {
+	if d.Get("security_mode").(bool) && d.Get("admin_pass").(string) == "" {
+		return fmt.Errorf("admin_pass is required when security_mode is enabled")
 	}
-	if err = d.Set("created", v); err != nil {
-		return fmt.Errorf("error setting Cluster:created, err: %s", err)
-	}
-
-	v, _ = opts["datastore"]
-	v, err = flattenCssClusterV1Datastore(response, nil, v)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:datastore, err: %s", err)
-	}
-	if err = d.Set("datastore", v); err != nil {
-		return fmt.Errorf("error setting Cluster:datastore, err: %s", err)
-	}
-
-	v, err = common.NavigateValue(response, []string{"read", "httpsEnable"}, nil)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:enable_https, err: %s", err)
-	}
-	if err = d.Set("enable_https", v); err != nil {
-		return fmt.Errorf("error setting Cluster:enable_https, err: %s", err)
-	}
-
-	v, err = common.NavigateValue(response, []string{"read", "endpoint"}, nil)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:endpoint, err: %s", err)
-	}
-	if err = d.Set("endpoint", v); err != nil {
-		return fmt.Errorf("error setting Cluster:endpoint, err: %s", err)
-	}
-
-	v, err = common.NavigateValue(response, []string{"read", "name"}, nil)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:name, err: %s", err)
-	}
-	if err = d.Set("name", v); err != nil {
-		return fmt.Errorf("error setting Cluster:name, err: %s", err)
-	}
-
-	v, _ = opts["node_config"]
-	v, err = flattenCssClusterV1NodeConfig(response, nil, v)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:node_config, err: %s", err)
-	}
-	if err = d.Set("node_config", v); err != nil {
-		return fmt.Errorf("error setting Cluster:node_config, err: %s", err)
-	}
-
-	v, _ = opts["nodes"]
-	v, err = flattenCssClusterV1Nodes(response, nil, v)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:nodes, err: %s", err)
-	}
-	if err = d.Set("nodes", v); err != nil {
-		return fmt.Errorf("error setting Cluster:nodes, err: %s", err)
-	}
-
-	v, err = common.NavigateValue(response, []string{"read", "updated"}, nil)
-	if err != nil {
-		return fmt.Errorf("error reading Cluster:updated, err: %s", err)
-	}
-	if err = d.Set("updated", v); err != nil {
-		return fmt.Errorf("error setting Cluster:updated, err: %s", err)
-	}
-
 	return nil
 }
 
-func flattenCssClusterV1Datastore(d interface{}, arrayIndex map[string]int, currentValue interface{}) (interface{}, error) {
-	result, ok := currentValue.([]interface{})
-	if !ok || len(result) == 0 {
-		result = make([]interface{}, 1, 1)
-	}
-	if result[0] == nil {
-		result[0] = make(map[string]interface{})
-	}
-	r := result[0].(map[string]interface{})
-
-	v, err := common.NavigateValue(d, []string{"read", "datastore", "type"}, arrayIndex)
-	if err != nil {
-		return nil, fmt.Errorf("error reading Cluster:type, err: %s", err)
-	}
-	r["type"] = v
-
-	v, err = common.NavigateValue(d, []string{"read", "datastore", "version"}, arrayIndex)
-	if err != nil {
-		return nil, fmt.Errorf("error reading Cluster:version, err: %s", err)
-	}
-	r["version"] = v
-
-	return result, nil
-}
-
-func flattenCssClusterV1NodeConfig(d interface{}, arrayIndex map[string]int, currentValue interface{}) (interface{}, error) {
-	result, ok := currentValue.([]interface{})
-	if !ok || len(result) == 0 {
-		result = make([]interface{}, 1, 1)
-	}
-	if result[0] == nil {
-		result[0] = make(map[string]interface{})
-	}
-	r := result[0].(map[string]interface{})
-
-	v, _ := r["network_info"]
-	v, err := flattenCssClusterV1NodeConfigNetworkInfo(d, arrayIndex, v)
-	if err != nil {
-		return nil, fmt.Errorf("error reading Cluster:network_info, err: %s", err)
+func updateCssClusterV1SecurityMode(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	if err := validateCssClusterV1SecurityMode(d); err != nil {
+		return err
 	}
-	r["network_info"] = v
 
-	v, _ = r["volume"]
-	v, err = flattenCssClusterV1NodeConfigVolume(d, arrayIndex, v)
-	if err != nil {
-		return nil, fmt.Errorf("error reading Cluster:volume, err: %s", err)
+	securityMode := d.Get("security_mode").(bool)
+	if d.HasChange("security_mode") {
+		opts := ModeChangeOpts{
+			AuthorityEnable: securityMode,
+			HttpsEnable:     d.Get("enable_https").(bool),
+		}
+		if securityMode {
+			opts.AdminPassword = d.Get("admin_pass").(string)
+		}
+		if err := ChangeMode(client, d.Id(), opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error changing Cluster security mode: %s", err)
+		}
+	} else if securityMode {
+		if err := ResetPassword(client, d.Id(), d.Get("admin_pass").(string)).ExtractErr(); err != nil {
+			return fmt.Errorf("error resetting Cluster admin password: %s", err)
+		}
 	}
-	r["volume"] = v
 
-	return result, nil
+	_, err := asyncWaitCssClusterV1Available(d, client, d.Timeout(schema.TimeoutUpdate))
+	return err
 }
 
-func flattenCssClusterV1NodeConfigNetworkInfo(d interface{}, arrayIndex map[string]int, currentValue interface{}) (interface{}, error) {
-	result, ok := currentValue.([]interface{})
-	if !ok || len(result) == 0 {
-		result = make([]interface{}, 1, 1)
-	}
-	if result[0] == nil {
-		result[0] = make(map[string]interface{})
+func updateCssClusterV1BackupStrategy(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	backupRaw := d.Get("backup_strategy").([]interface{})
+	if len(backupRaw) == 0 {
+		if err := snapshots.Disable(client, d.Id()).ExtractErr(); err != nil {
+			return fmt.Errorf("error disabling Cluster snapshots: %s", err)
+		}
+		_, err := asyncWaitCssClusterV1Available(d, client, d.Timeout(schema.TimeoutUpdate))
+		return err
 	}
-	r := result[0].(map[string]interface{})
+	backup := backupRaw[0].(map[string]interface{})
 
-	v, err := common.NavigateValue(d, []string{"read", "subnetId"}, arrayIndex)
-	if err != nil {
-		return nil, fmt.Errorf("error reading Cluster:network_id, err: %s", err)
+	settingOpts := SnapshotSettingOpts{
+		Bucket:   backup["bucket"].(string),
+		Agency:   backup["agency"].(string),
+		BasePath: backup["base_path"].(string),
 	}
-	r["network_id"] = v
-
-	v, err = common.NavigateValue(d, []string{"read", "securityGroupId"}, arrayIndex)
-	if err != nil {
-		return nil, fmt.Errorf("error reading Cluster:security_group_id, err: %s", err)
+	if err := UpdateSnapshotSetting(client, d.Id(), settingOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting Cluster snapshot configuration: %s", err)
 	}
-	r["security_group_id"] = v
-
-	return result, nil
-}
 
-func flattenCssClusterV1NodeConfigVolume(d interface{}, arrayIndex map[string]int, currentValue interface{}) (interface{}, error) {
-	result, ok := currentValue.([]interface{})
-	if !ok || len(result) == 0 {
-		result = make([]interface{}, 1, 1)
+	policyOpts := snapshots.PolicyCreateOpts{
+		Prefix:  backup["prefix"].(string),
+		Period:  backup["start_time"].(string),
+		KeepDay: backup["keep_days"].(int),
+		Enable:  "true",
 	}
-	if result[0] == nil {
-		result[0] = make(map[string]interface{})
+	if err := snapshots.PolicyCreate(client, policyOpts, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting Cluster snapshot policy: %s", err)
 	}
-	r := result[0].(map[string]interface{})
 
-	v, err := common.NavigateValue(d, []string{"read", "cmkId"}, arrayIndex)
-	if err != nil {
-		v = ""
-	}
-	r["encryption_key"] = v
-
-	return result, nil
+	_, err := asyncWaitCssClusterV1Available(d, client, d.Timeout(schema.TimeoutUpdate))
+	return err
 }
 
-func flattenCssClusterV1Nodes(d interface{}, arrayIndex map[string]int, currentValue interface{}) (interface{}, error) {
-	result, ok := currentValue.([]interface{})
-	if !ok || len(result) == 0 {
-		v, err := common.NavigateValue(d, []string{"read", "instances"}, arrayIndex)
-		if err != nil {
-			return nil, err
-		}
-		n := len(v.([]interface{}))
-		result = make([]interface{}, n, n)
-	}
+func updateCssClusterV1PublicKibana(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldRaw, newRaw := d.GetChange("kibana_public_access")
+	oldList, newList := oldRaw.([]interface{}), newRaw.([]interface{})
 
-	newArrayIndex := make(map[string]int)
-	if arrayIndex != nil {
-		for k, v := range arrayIndex {
-			newArrayIndex[k] = v
+	switch {
+	case len(newList) == 0:
+		if err := ClosePublicKibana(client, d.Id()).ExtractErr(); err != nil {
+			return fmt.Errorf("error disabling Cluster Kibana public access: %s", err)
 		}
-	}
-
-	for i := 0; i < len(result); i++ {
-		newArrayIndex["read.instances"] = i
-		if result[i] == nil {
-			result[i] = make(map[string]interface{})
+	case len(oldList) == 0:
+		access := newList[0].(map[string]interface{})
+		opts := PublicKibanaOpts{
+			EipSize: access["bandwidth"].(int),
+			WhiteList: &PublicKibanaWhitelist{
+				Enable:    access["whitelist_enabled"].(bool),
+				WhiteList: access["whitelist"].(string),
+			},
 		}
-		r := result[i].(map[string]interface{})
-
-		v, err := common.NavigateValue(d, []string{"read", "instances", "id"}, newArrayIndex)
-		if err != nil {
-			return nil, fmt.Errorf("error reading Cluster:id, err: %s", err)
+		if err := OpenPublicKibana(client, d.Id(), opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error enabling Cluster Kibana public access: %s", err)
 		}
-		r["id"] = v
-
-		v, err = common.NavigateValue(d, []string{"read", "instances", "name"}, newArrayIndex)
-		if err != nil {
-			return nil, fmt.Errorf("error reading Cluster:name, err: %s", err)
+	default:
+		oldAccess, access := oldList[0].(map[string]interface{}), newList[0].(map[string]interface{})
+		if oldAccess["bandwidth"].(int) != access["bandwidth"].(int) {
+			err := UpdatePublicKibanaBandwidth(client, d.Id(), access["bandwidth"].(int)).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("error changing Cluster Kibana public access bandwidth: %s", err)
+			}
+			if _, err := asyncWaitCssClusterV1Available(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
 		}
-		r["name"] = v
-
-		v, err = common.NavigateValue(d, []string{"read", "instances", "type"}, newArrayIndex)
-		if err != nil {
-			return nil, fmt.Errorf("error reading Cluster:type, err: %s", err)
+		if oldAccess["whitelist_enabled"].(bool) != access["whitelist_enabled"].(bool) ||
+			oldAccess["whitelist"].(string) != access["whitelist"].(string) {
+			var err error
+			if access["whitelist_enabled"].(bool) {
+				err = UpdatePublicKibanaWhitelist(client, d.Id(), access["whitelist"].(string)).ExtractErr()
+			} else {
+				err = ClosePublicKibanaWhitelist(client, d.Id()).ExtractErr()
+			}
+			if err != nil {
+				return fmt.Errorf("error changing Cluster Kibana public access whitelist: %s", err)
+			}
 		}
-		r["type"] = v
 	}
 
-	return result, nil
+	_, err := asyncWaitCssClusterV1Available(d, client, d.Timeout(schema.TimeoutUpdate))
+	return err
 }
@@ -0,0 +1,257 @@
+package css
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ModeChangeOpts represents the attributes used when changing the CSS cluster security mode.
+type ModeChangeOpts struct {
+	AuthorityEnable bool   `json:"authorityEnable"`
+	AdminPassword   string `json:"adminPwd,omitempty"`
+	HttpsEnable     bool   `json:"httpsEnable"`
+}
+
+// ChangeMode enables or disables the security mode of the CSS cluster.
+func ChangeMode(client *golangsdk.ServiceClient, clusterID string, opts ModeChangeOpts) (r golangsdk.ErrResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "mode", "change"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ResetPassword changes the administrator password of the CSS cluster in the security mode.
+func ResetPassword(client *golangsdk.ServiceClient, clusterID, password string) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{"newpassword": password}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "password", "reset"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// SnapshotSettingOpts represents the basic configuration of the CSS cluster snapshots.
+type SnapshotSettingOpts struct {
+	Bucket   string `json:"bucket" required:"true"`
+	Agency   string `json:"agency" required:"true"`
+	BasePath string `json:"basePath,omitempty"`
+}
+
+// UpdateSnapshotSetting changes the OBS bucket, the agency and the base path used for the CSS cluster snapshots.
+func UpdateSnapshotSetting(client *golangsdk.ServiceClient, clusterID string, opts SnapshotSettingOpts) (r golangsdk.ErrResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "index_snapshot", "setting"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// PublicKibanaWhitelist represents the whitelist of the CSS cluster Kibana public access.
+type PublicKibanaWhitelist struct {
+	Enable    bool   `json:"enableWhiteList"`
+	WhiteList string `json:"whiteList,omitempty"`
+}
+
+// PublicKibanaOpts represents the attributes used when enabling the CSS cluster Kibana public access.
+type PublicKibanaOpts struct {
+	EipSize   int                    `json:"eipSize" required:"true"`
+	WhiteList *PublicKibanaWhitelist `json:"elbWhiteList,omitempty"`
+}
+
+// OpenPublicKibana enables the Kibana public access of the CSS cluster.
+func OpenPublicKibana(client *golangsdk.ServiceClient, clusterID string, opts PublicKibanaOpts) (r golangsdk.ErrResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "publickibana", "open"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ClosePublicKibana disables the Kibana public access of the CSS cluster.
+func ClosePublicKibana(client *golangsdk.ServiceClient, clusterID string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Put(client.ServiceURL("clusters", clusterID, "publickibana", "close"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdatePublicKibanaBandwidth changes the bandwidth of the CSS cluster Kibana public access.
+func UpdatePublicKibanaBandwidth(client *golangsdk.ServiceClient, clusterID string, size int) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{
+		"bandWidth": map[string]interface{}{"size": size},
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "publickibana", "bandwidth"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdatePublicKibanaWhitelist enables or changes the whitelist of the CSS cluster Kibana public access.
+func UpdatePublicKibanaWhitelist(client *golangsdk.ServiceClient, clusterID, whitelist string) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{"whiteIpList": whitelist}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "publickibana", "whitelist", "update"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ClosePublicKibanaWhitelist disables the whitelist of the CSS cluster Kibana public access.
+func ClosePublicKibanaWhitelist(client *golangsdk.ServiceClient, clusterID string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Put(client.ServiceURL("clusters", clusterID, "publickibana", "whitelist", "close"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Nics represents the network configuration of the CSS cluster.
+type Nics struct {
+	VpcID           string `json:"vpcId" required:"true"`
+	NetID           string `json:"netId" required:"true"`
+	SecurityGroupID string `json:"securityGroupId" required:"true"`
+}
+
+// VolumeSpec represents the volume configuration of the CSS cluster nodes.
+type VolumeSpec struct {
+	VolumeType string `json:"volume_type" required:"true"`
+	Size       int    `json:"size" required:"true"`
+}
+
+// InstanceSpec represents the node configuration of the CSS cluster.
+type InstanceSpec struct {
+	FlavorRef        string     `json:"flavorRef" required:"true"`
+	Volume           VolumeSpec `json:"volume" required:"true"`
+	Nics             Nics       `json:"nics" required:"true"`
+	AvailabilityZone string     `json:"availability_zone,omitempty"`
+}
+
+// DiskEncryption represents the disk encryption configuration of the CSS cluster.
+type DiskEncryption struct {
+	SystemEncrypted string `json:"systemEncrypted" required:"true"`
+	SystemCmkID     string `json:"systemCmkid,omitempty"`
+}
+
+// ClusterCreateOpts represents the attributes used when creating a new CSS cluster.
+type ClusterCreateOpts struct {
+	Name            string            `json:"name" required:"true"`
+	InstanceNum     int               `json:"instanceNum" required:"true"`
+	Instance        InstanceSpec      `json:"instance" required:"true"`
+	HttpsEnable     string            `json:"httpsEnable,omitempty"`
+	DiskEncryption  *DiskEncryption   `json:"diskEncryption,omitempty"`
+	AuthorityEnable bool              `json:"authorityEnable,omitempty"`
+	AdminPassword   string            `json:"adminPwd,omitempty"`
+	PublicKibana    *PublicKibanaOpts `json:"publicKibanaReq,omitempty"`
+}
+
+// ToClusterCreateMap builds a request body from ClusterCreateOpts.
+func (opts ClusterCreateOpts) ToClusterCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "cluster")
+}
+
+// Datastore represents the search engine of the CSS cluster.
+type Datastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// ClusterVolume represents the volume of the CSS cluster node.
+type ClusterVolume struct {
+	Type string `json:"type"`
+	Size int    `json:"size"`
+}
+
+// ClusterInstance represents a node of the CSS cluster.
+type ClusterInstance struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	Type     string        `json:"type"`
+	Status   string        `json:"status"`
+	SpecCode string        `json:"specCode"`
+	AzCode   string        `json:"azCode"`
+	Volume   ClusterVolume `json:"volume"`
+}
+
+// PublicKibanaResp represents the Kibana public access of the CSS cluster.
+type PublicKibanaResp struct {
+	EipSize        int                   `json:"eipSize"`
+	PublicKibanaIP string                `json:"publicKibanaIp"`
+	WhiteList      PublicKibanaWhitelist `json:"elbWhiteListResp"`
+}
+
+// Cluster represents the CSS cluster.
+type Cluster struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	Status          string            `json:"status"`
+	Created         string            `json:"created"`
+	Updated         string            `json:"updated"`
+	Endpoint        string            `json:"endpoint"`
+	VpcID           string            `json:"vpcId"`
+	SubnetID        string            `json:"subnetId"`
+	SecurityGroupID string            `json:"securityGroupId"`
+	HttpsEnable     bool              `json:"httpsEnable"`
+	AuthorityEnable bool              `json:"authorityEnable"`
+	DiskEncrypted   bool              `json:"diskEncrypted"`
+	CmkID           string            `json:"cmkId"`
+	Datastore       Datastore         `json:"datastore"`
+	Instances       []ClusterInstance `json:"instances"`
+	PublicKibana    *PublicKibanaResp `json:"publicKibanaResp"`
+}
+
+// CreateCluster creates a new CSS cluster.
+func CreateCluster(client *golangsdk.ServiceClient, opts ClusterCreateOpts) (*Cluster, error) {
+	b, err := opts.ToClusterCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("clusters"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	var s struct {
+		Cluster Cluster `json:"cluster"`
+	}
+	err = r.ExtractInto(&s)
+	return &s.Cluster, err
+}
+
+// GetCluster retrieves the CSS cluster details.
+func GetCluster(client *golangsdk.ServiceClient, clusterID string) (*Cluster, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID), &r.Body, nil)
+
+	var s Cluster
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtendCluster adds nodes to the CSS cluster.
+func ExtendCluster(client *golangsdk.ServiceClient, clusterID string, size int) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{
+		"grow": map[string]interface{}{"modifySize": size},
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "extend"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteCluster deletes the CSS cluster.
+func DeleteCluster(client *golangsdk.ServiceClient, clusterID string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("clusters", clusterID), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -1,44 +1,13 @@
 package css
 
-import (
-	"fmt"
-
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-
-	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
-)
-
-func checkCssClusterV1ExtendClusterFinished(data interface{}) bool {
-	instances, err := common.NavigateValue(data, []string{"instances"}, nil)
-	if err != nil {
+func checkCssClusterV1ExtendClusterFinished(cluster *Cluster) bool {
+	if len(cluster.Instances) == 0 {
 		return false
 	}
-	if v, ok := instances.([]interface{}); ok {
-		if len(v) == 0 {
+	for _, instance := range cluster.Instances {
+		if instance.Status != "200" {
 			return false
 		}
-		for _, item := range v {
-			status, err := common.NavigateValue(item, []string{"status"}, nil)
-			if err != nil {
-				return false
-			}
-			if s, ok := status.(string); !ok || "200" != s {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
-func expandCssClusterV1ExtendClusterNodeNum(d interface{}, _ map[string]int) (interface{}, error) {
-	t, _ := common.NavigateValue(d, []string{"terraform_resource_data"}, nil)
-	rd := t.(*schema.ResourceData)
-
-	oldv, newv := rd.GetChange("expect_node_num")
-	v := newv.(int) - oldv.(int)
-	if v < 0 {
-		return 0, fmt.Errorf("it only supports extending nodes")
 	}
-	return v, nil
+	return true
 }
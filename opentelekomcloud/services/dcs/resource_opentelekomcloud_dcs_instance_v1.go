@@ -3,6 +3,8 @@ package dcs
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -21,10 +23,17 @@ func ResourceDcsInstanceV1() *schema.Resource {
 		Read:   resourceDcsInstancesV1Read,
 		Update: resourceDcsInstancesV1Update,
 		Delete: resourceDcsInstancesV1Delete,
+
+		CustomizeDiff: validateDcsInstanceConfiguration,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -48,13 +57,11 @@ func ResourceDcsInstanceV1() *schema.Resource {
 			"capacity": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"password": {
 				Type:      schema.TypeString,
 				Sensitive: true,
 				Required:  true,
-				ForceNew:  true,
 			},
 			"access_user": {
 				Type:     schema.TypeString,
@@ -166,6 +173,11 @@ func ResourceDcsInstanceV1() *schema.Resource {
 					},
 				},
 			},
+			"configuration": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"order_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -319,6 +331,12 @@ func resourceDcsInstancesV1Create(d *schema.ResourceData, meta interface{}) erro
 	// Store the instance ID now
 	d.SetId(v.InstanceID)
 
+	if len(d.Get("configuration").(map[string]interface{})) > 0 {
+		if err := updateDcsInstanceConfiguration(d, DcsV1Client, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceDcsInstancesV1Read(d, meta)
 }
 
@@ -364,7 +382,28 @@ func resourceDcsInstancesV1Read(d *schema.ResourceData, meta interface{}) error
 		d.Set("access_user", v.AccessUser),
 		d.Set("ip", v.IP),
 	)
-	return mErr.ErrorOrNil()
+	if mErr.ErrorOrNil() != nil {
+		return mErr
+	}
+
+	if v.Engine != "Redis" {
+		return nil
+	}
+	configuration := d.Get("configuration").(map[string]interface{})
+	if len(configuration) == 0 {
+		return nil
+	}
+	configs, err := GetConfigs(DcsV1Client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading DCS instance configuration: %s", err)
+	}
+	// only the managed parameters are refreshed, parameters tuned outside of terraform are kept as is
+	for _, item := range configs.RedisConfigs {
+		if _, ok := configuration[item.ParamName]; ok {
+			configuration[item.ParamName] = item.ParamValue
+		}
+	}
+	return d.Set("configuration", configuration)
 }
 
 func resourceDcsInstancesV1Update(d *schema.ResourceData, meta interface{}) error {
@@ -373,30 +412,74 @@ func resourceDcsInstancesV1Update(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("Error updating dcs instance client: %s", err)
 	}
-	var updateOpts instances.UpdateOpts
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
-	}
-	if d.HasChange("description") {
-		description := d.Get("description").(string)
-		updateOpts.Description = &description
-	}
-	if d.HasChange("maintain_begin") {
-		updateOpts.MaintainBegin = d.Get("maintain_begin").(string)
-	}
-	if d.HasChange("maintain_end") {
-		updateOpts.MaintainEnd = d.Get("maintain_end").(string)
+
+	if d.HasChanges("name", "description", "maintain_begin", "maintain_end", "security_group_id", "backup_policy") {
+		var updateOpts instances.UpdateOpts
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("maintain_begin") {
+			updateOpts.MaintainBegin = d.Get("maintain_begin").(string)
+		}
+		if d.HasChange("maintain_end") {
+			updateOpts.MaintainEnd = d.Get("maintain_end").(string)
+		}
+		if d.HasChange("security_group_id") {
+			updateOpts.SecurityGroupID = d.Get("security_group_id").(string)
+		}
+		if d.HasChange("backup_policy") {
+			updateOpts.InstanceBackupPolicy = getInstanceBackupPolicy(d)
+		}
+
+		err = instances.Update(DcsV1Client, d.Id(), updateOpts).Err
+		if err != nil {
+			return fmt.Errorf("Error updating Dcs Instance: %s", err)
+		}
 	}
-	if d.HasChange("security_group_id") {
-		updateOpts.SecurityGroupID = d.Get("security_group_id").(string)
+
+	if d.HasChange("configuration") {
+		if err := updateDcsInstanceConfiguration(d, DcsV1Client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
-	if d.HasChange("backup_policy") {
-		updateOpts.InstanceBackupPolicy = getInstanceBackupPolicy(d)
+
+	if d.HasChange("capacity") {
+		oldCapacity, newCapacity := d.GetChange("capacity")
+		if newCapacity.(int) < oldCapacity.(int) {
+			return fmt.Errorf("DCS instance capacity can only be expanded, got %d, current is %d", newCapacity, oldCapacity)
+		}
+		extendOpts := instances.ExtendOpts{
+			NewCapacity: newCapacity.(int),
+		}
+		log.Printf("[DEBUG] Extend Options: %#v", extendOpts)
+		if err := instances.Extend(DcsV1Client, d.Id(), extendOpts).Err; err != nil {
+			return fmt.Errorf("Error extending Dcs Instance capacity: %s", err)
+		}
+		if err := waitForDcsInstanceRunning(d, DcsV1Client); err != nil {
+			return err
+		}
 	}
 
-	err = instances.Update(DcsV1Client, d.Id(), updateOpts).Err
-	if err != nil {
-		return fmt.Errorf("Error updating Dcs Instance: %s", err)
+	if d.HasChange("password") {
+		oldPassword, newPassword := d.GetChange("password")
+		passwordOpts := instances.UpdatePasswordOpts{
+			OldPassword: oldPassword.(string),
+			NewPassword: newPassword.(string),
+		}
+		v, err := instances.UpdatePassword(DcsV1Client, d.Id(), passwordOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating Dcs Instance password: %s", err)
+		}
+		if v.Result != "Success" {
+			return fmt.Errorf("Error updating Dcs Instance password: %s, %s", v.Result, v.Message)
+		}
+		if err := waitForDcsInstanceRunning(d, DcsV1Client); err != nil {
+			return err
+		}
 	}
 
 	return resourceDcsInstancesV1Read(d, meta)
@@ -443,6 +526,217 @@ func resourceDcsInstancesV1Delete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// redisCommonConfigurationParameters lists the parameters which can be modified for all DCS Redis versions
+var redisCommonConfigurationParameters = []string{
+	"appendfsync",
+	"appendonly",
+	"client-output-buffer-limit-slave-soft-seconds",
+	"client-output-buffer-slave-hard-limit",
+	"client-output-buffer-slave-soft-limit",
+	"hash-max-ziplist-entries",
+	"hash-max-ziplist-value",
+	"latency-monitor-threshold",
+	"lua-time-limit",
+	"master-read-only",
+	"maxclients",
+	"maxmemory-policy",
+	"notify-keyspace-events",
+	"repl-backlog-size",
+	"repl-backlog-ttl",
+	"repl-timeout",
+	"reserved-memory-percent",
+	"set-max-intset-entries",
+	"slowlog-log-slower-than",
+	"slowlog-max-len",
+	"timeout",
+	"zset-max-ziplist-entries",
+	"zset-max-ziplist-value",
+}
+
+// redis4ConfigurationParameters lists the parameters added in DCS Redis 4.0
+var redis4ConfigurationParameters = []string{
+	"active-expire-num",
+	"list-compress-depth",
+	"list-max-ziplist-size",
+	"proto-max-bulk-len",
+}
+
+// redisConfigurationParameters lists the parameters which can be modified for DCS Redis instances
+// by the engine version, it's used to validate configuration of the instances which don't exist yet
+var redisConfigurationParameters = map[string][]string{
+	"3.0": append(append([]string{}, redisCommonConfigurationParameters...),
+		"list-max-ziplist-entries",
+		"list-max-ziplist-value",
+	),
+	"4.0": append(append([]string{}, redisCommonConfigurationParameters...), redis4ConfigurationParameters...),
+	"5.0": append(append(append([]string{}, redisCommonConfigurationParameters...), redis4ConfigurationParameters...),
+		"stream-node-max-bytes",
+		"stream-node-max-entries",
+	),
+}
+
+// validateDcsInstanceConfiguration checks `configuration` parameter names during plan,
+// so a misspelled name doesn't fail the apply after the instance is already created
+func validateDcsInstanceConfiguration(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("configuration") || (d.Id() != "" && !d.HasChange("configuration")) {
+		return nil
+	}
+	configuration := d.Get("configuration").(map[string]interface{})
+	if len(configuration) == 0 {
+		return nil
+	}
+	if engine := d.Get("engine").(string); engine != "Redis" {
+		return fmt.Errorf("configuration parameters are supported only for Redis instances, got: %s", engine)
+	}
+
+	available := make(map[string]bool)
+	if d.Id() == "" {
+		parameters, ok := redisConfigurationParameters[d.Get("engine_version").(string)]
+		if !ok {
+			// parameters of unknown versions are validated by the API
+			return nil
+		}
+		for _, name := range parameters {
+			available[name] = true
+		}
+	} else {
+		config := meta.(*cfg.Config)
+		client, err := config.DcsV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("Error creating dcs instance client: %s", err)
+		}
+		configs, err := GetConfigs(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading DCS instance configuration: %s", err)
+		}
+		for _, item := range configs.RedisConfigs {
+			available[item.ParamName] = true
+		}
+	}
+
+	var unknown []string
+	for name := range configuration {
+		if !available[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unsupported configuration parameters for Redis %s: %s",
+			d.Get("engine_version").(string), strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func updateDcsInstanceConfiguration(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	if engine := d.Get("engine").(string); engine != "Redis" {
+		return fmt.Errorf("configuration parameters are supported only for Redis instances, got: %s", engine)
+	}
+
+	configs, err := GetConfigs(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading DCS instance configuration: %s", err)
+	}
+	available := make(map[string]RedisConfig)
+	for _, config := range configs.RedisConfigs {
+		available[config.ParamName] = config
+	}
+
+	oldRaw, newRaw := d.GetChange("configuration")
+	oldConfiguration := oldRaw.(map[string]interface{})
+	newConfiguration := newRaw.(map[string]interface{})
+
+	var unknown []string
+	var redisConfigs []RedisConfigOpts
+	for name, value := range newConfiguration {
+		config, ok := available[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		if config.ParamValue == value.(string) {
+			continue
+		}
+		redisConfigs = append(redisConfigs, RedisConfigOpts{
+			ParamID:    config.ParamID,
+			ParamName:  name,
+			ParamValue: value.(string),
+		})
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unsupported configuration parameters for Redis %s: %s",
+			d.Get("engine_version").(string), strings.Join(unknown, ", "))
+	}
+	// parameters removed from the configuration are restored to their defaults
+	for name := range oldConfiguration {
+		if _, ok := newConfiguration[name]; ok {
+			continue
+		}
+		config, ok := available[name]
+		if !ok || config.ParamValue == config.DefaultValue {
+			continue
+		}
+		redisConfigs = append(redisConfigs, RedisConfigOpts{
+			ParamID:    config.ParamID,
+			ParamName:  name,
+			ParamValue: config.DefaultValue,
+		})
+	}
+	if len(redisConfigs) == 0 {
+		return nil
+	}
+
+	updateOpts := ConfigsUpdateOpts{RedisConfigs: redisConfigs}
+	log.Printf("[DEBUG] Configuration Update Options: %#v", updateOpts)
+	if err := UpdateConfigs(client, d.Id(), updateOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error updating DCS instance configuration: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UPDATING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    dcsInstanceV1ConfigStateRefreshFunc(client, d.Id()),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) configuration to be applied: %s",
+			d.Id(), err)
+	}
+	return nil
+}
+
+func dcsInstanceV1ConfigStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := GetConfigs(client, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		return v, v.ConfigStatus, nil
+	}
+}
+
+func waitForDcsInstanceRunning(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"EXTENDING", "RESTARTING", "STARTING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    DcsInstancesV1StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to become running: %s",
+			d.Id(), err)
+	}
+	return nil
+}
+
 func DcsInstancesV1StateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		v, err := instances.Get(client, instanceID).Extract()
@@ -0,0 +1,163 @@
+package dcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDcsWhitelistV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDcsWhitelistV1Create,
+		Read:   resourceDcsWhitelistV1Read,
+		Update: resourceDcsWhitelistV1Update,
+		Delete: resourceDcsWhitelistV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enable_whitelist": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"group": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip_list": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getWhitelistGroups(d *schema.ResourceData) []whitelists.WhitelistGroupOpts {
+	groupsRaw := d.Get("group").([]interface{})
+	groups := make([]whitelists.WhitelistGroupOpts, len(groupsRaw))
+	for i, v := range groupsRaw {
+		group := v.(map[string]interface{})
+		groups[i] = whitelists.WhitelistGroupOpts{
+			GroupName: group["group_name"].(string),
+			IPList:    common.ExpandToStringSlice(group["ip_list"].([]interface{})),
+		}
+	}
+	return groups
+}
+
+func putDcsWhitelist(d *schema.ResourceData, meta interface{}, opts whitelists.WhitelistOpts) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating DCSv2 client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Whitelist Options: %#v", opts)
+	if err := whitelists.Put(client, d.Id(), opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting DCS instance whitelist: %s", err)
+	}
+
+	return waitForDcsInstanceRunning(d, client)
+}
+
+func resourceDcsWhitelistV1Create(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("instance_id").(string))
+
+	enable := d.Get("enable_whitelist").(bool)
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: getWhitelistGroups(d),
+	}
+	if err := putDcsWhitelist(d, meta, opts); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceDcsWhitelistV1Read(d, meta)
+}
+
+func resourceDcsWhitelistV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating DCSv2 client: %s", err)
+	}
+
+	wl, err := whitelists.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error reading DCS instance whitelist")
+	}
+	log.Printf("[DEBUG] DCS whitelist %s: %+v", d.Id(), wl)
+
+	groups := make([]map[string]interface{}, len(wl.Groups))
+	for i, group := range wl.Groups {
+		groups[i] = map[string]interface{}{
+			"group_name": group.GroupName,
+			"ip_list":    group.IPList,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", d.Id()),
+		d.Set("enable_whitelist", wl.Enable),
+		d.Set("group", groups),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceDcsWhitelistV1Update(d *schema.ResourceData, meta interface{}) error {
+	enable := d.Get("enable_whitelist").(bool)
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: getWhitelistGroups(d),
+	}
+	if err := putDcsWhitelist(d, meta, opts); err != nil {
+		return err
+	}
+
+	return resourceDcsWhitelistV1Read(d, meta)
+}
+
+func resourceDcsWhitelistV1Delete(d *schema.ResourceData, meta interface{}) error {
+	enable := false
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: []whitelists.WhitelistGroupOpts{},
+	}
+	if err := putDcsWhitelist(d, meta, opts); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,65 @@
+package dcs
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RedisConfig represents a single configuration parameter of the DCS Redis instance.
+type RedisConfig struct {
+	ParamID      string `json:"param_id"`
+	ParamName    string `json:"param_name"`
+	ParamValue   string `json:"param_value"`
+	DefaultValue string `json:"default_value"`
+	ValueType    string `json:"value_type"`
+	ValueRange   string `json:"value_range"`
+	Description  string `json:"description"`
+}
+
+// InstanceConfigs represents the configuration parameters of the DCS Redis instance.
+type InstanceConfigs struct {
+	InstanceID   string        `json:"instance_id"`
+	Status       string        `json:"status"`
+	ConfigStatus string        `json:"config_status"`
+	ConfigTime   string        `json:"config_time"`
+	RedisConfigs []RedisConfig `json:"redis_config"`
+}
+
+// RedisConfigOpts represents a single parameter used when modifying instance configuration.
+type RedisConfigOpts struct {
+	ParamID    string `json:"param_id" required:"true"`
+	ParamName  string `json:"param_name" required:"true"`
+	ParamValue string `json:"param_value" required:"true"`
+}
+
+// ConfigsUpdateOpts represents the attributes used when modifying instance configuration.
+type ConfigsUpdateOpts struct {
+	RedisConfigs []RedisConfigOpts `json:"redis_config" required:"true"`
+}
+
+// ToConfigsUpdateMap builds a request body from ConfigsUpdateOpts.
+func (opts ConfigsUpdateOpts) ToConfigsUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// GetConfigs retrieves configuration parameters of the DCS instance.
+func GetConfigs(client *golangsdk.ServiceClient, instanceID string) (*InstanceConfigs, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceID, "configs"), &r.Body, nil)
+
+	var s InstanceConfigs
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpdateConfigs modifies configuration parameters of the DCS instance.
+func UpdateConfigs(client *golangsdk.ServiceClient, instanceID string, opts ConfigsUpdateOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToConfigsUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("instances", instanceID, "configs"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
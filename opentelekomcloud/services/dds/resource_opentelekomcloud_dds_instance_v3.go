@@ -3,6 +3,7 @@ package dds
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -29,6 +30,7 @@ func ResourceDdsInstanceV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -111,7 +113,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"flavor": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -125,7 +126,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"num": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(1, 16),
 						},
 						"storage": {
@@ -139,12 +139,10 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"size": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 						"spec_code": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -152,7 +150,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"backup_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -498,21 +495,210 @@ func resourceDdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating instance from result: %s ", r.Err)
 	}
 
+	if err := waitForDdsInstanceV3Normal(d, client); err != nil {
+		return err
+	}
+
+	if d.HasChange("backup_strategy") {
+		backupStrategy := resourceDdsBackupStrategy(d)
+		backupOpts := BackupPolicyOpts{
+			StartTime: backupStrategy.StartTime,
+			KeepDays:  &backupStrategy.KeepDays,
+		}
+		log.Printf("[DEBUG] Backup Policy Options: %#v", backupOpts)
+		if err := UpdateBackupPolicy(client, d.Id(), backupOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("error updating DDS instance backup strategy: %s", err)
+		}
+	}
+
+	if d.HasChange("flavor") {
+		if err := resourceDdsInstanceV3UpdateFlavor(d, client); err != nil {
+			return err
+		}
+		if err := waitForDdsInstanceV3Normal(d, client); err != nil {
+			return err
+		}
+	}
+
+	return resourceDdsInstanceV3Read(d, meta)
+}
+
+func waitForDdsInstanceV3Normal(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"updating"},
 		Target:     []string{"normal"},
 		Refresh:    DdsInstanceStateRefreshFunc(client, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      15 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to become ready: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func ddsJobStateRefreshFunc(client *golangsdk.ServiceClient, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := GetJob(client, jobID)
+		if err != nil {
+			return nil, "", err
+		}
+		if job.Status == "Failed" {
+			return job, job.Status, fmt.Errorf("DDS job %s failed: %s", jobID, job.Fail)
+		}
+		return job, job.Status, nil
+	}
+}
+
+func waitForDdsJob(d *schema.ResourceData, client *golangsdk.ServiceClient, r JobResult) error {
+	jobID, err := r.ExtractJobID()
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Running"},
+		Target:     []string{"Completed"},
+		Refresh:    ddsJobStateRefreshFunc(client, jobID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      15 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
 	_, err = stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("Error waiting for instance (%s) to become ready: %s ", d.Id(), err)
+		return fmt.Errorf("error waiting for DDS job (%s) to complete: %s", jobID, err)
 	}
+	return nil
+}
 
-	return resourceDdsInstanceV3Read(d, meta)
+func getDdsInstanceV3(client *golangsdk.ServiceClient, instanceID string) (*instances.InstanceResponse, error) {
+	listOpts := instances.ListInstanceOpts{
+		Id: instanceID,
+	}
+	allPages, err := instances.List(client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	instancesList, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nil, err
+	}
+	if len(instancesList.Instances) == 0 {
+		return nil, fmt.Errorf("DDS instance %s not found", instanceID)
+	}
+	return &instancesList.Instances[0], nil
+}
+
+// resourceDdsInstanceV3UpdateFlavor resizes node specifications, expands storage and adds nodes.
+// Existing nodes are resized first, so that the new nodes are created with the target spec_code.
+func resourceDdsInstanceV3UpdateFlavor(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldRaw, newRaw := d.GetChange("flavor")
+	oldFlavors := make(map[string]map[string]interface{})
+	for _, v := range oldRaw.([]interface{}) {
+		flavor := v.(map[string]interface{})
+		oldFlavors[flavor["type"].(string)] = flavor
+	}
+
+	instance, err := getDdsInstanceV3(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+
+	for _, v := range newRaw.([]interface{}) {
+		newFlavor := v.(map[string]interface{})
+		flavorType := newFlavor["type"].(string)
+		oldFlavor, ok := oldFlavors[flavorType]
+		if !ok {
+			return fmt.Errorf("flavor of %s nodes can't be added to the existing instance", flavorType)
+		}
+
+		oldNum, newNum := oldFlavor["num"].(int), newFlavor["num"].(int)
+		oldSize, newSize := oldFlavor["size"].(int), newFlavor["size"].(int)
+		specCode := newFlavor["spec_code"].(string)
+
+		if newNum < oldNum {
+			return fmt.Errorf("number of %s nodes can't be decreased", flavorType)
+		}
+		if newNum > oldNum && flavorType != "mongos" && flavorType != "shard" {
+			return fmt.Errorf("only mongos and shard nodes can be added, got: %s", flavorType)
+		}
+		if newSize < oldSize {
+			return fmt.Errorf("storage size of %s nodes can't be decreased", flavorType)
+		}
+		if newSize > oldSize && flavorType != "shard" && flavorType != "replica" {
+			return fmt.Errorf("only storage of shard and replica nodes can be expanded, got: %s", flavorType)
+		}
+
+		if specCode != oldFlavor["spec_code"].(string) {
+			for _, opts := range ddsResizeTargets(instance, flavorType, specCode) {
+				log.Printf("[DEBUG] Resize Options: %#v", opts)
+				if err := waitForDdsJob(d, client, Resize(client, d.Id(), opts)); err != nil {
+					return fmt.Errorf("error resizing DDS %s nodes: %s", flavorType, err)
+				}
+			}
+		}
+
+		if newSize > oldSize {
+			volumes := []VolumeOpts{{Size: strconv.Itoa(newSize)}}
+			if flavorType == "shard" {
+				volumes = nil
+				for _, group := range instance.Groups {
+					if group.Type == "shard" {
+						volumes = append(volumes, VolumeOpts{GroupID: group.Id, Size: strconv.Itoa(newSize)})
+					}
+				}
+			}
+			for _, opts := range volumes {
+				log.Printf("[DEBUG] Enlarge Volume Options: %#v", opts)
+				if err := waitForDdsJob(d, client, EnlargeVolume(client, d.Id(), opts)); err != nil {
+					return fmt.Errorf("error expanding DDS %s storage: %s", flavorType, err)
+				}
+			}
+		}
+
+		if newNum > oldNum {
+			opts := EnlargeOpts{
+				Type:     flavorType,
+				SpecCode: specCode,
+				Num:      newNum - oldNum,
+			}
+			if flavorType == "shard" {
+				opts.Volume = &VolumeOpts{Size: strconv.Itoa(newSize)}
+			}
+			log.Printf("[DEBUG] Enlarge Options: %#v", opts)
+			if err := waitForDdsJob(d, client, Enlarge(client, d.Id(), opts)); err != nil {
+				return fmt.Errorf("error adding DDS %s nodes: %s", flavorType, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ddsResizeTargets returns resize options for every node or group of the given type:
+// mongos nodes are resized one by one, shard and config nodes by group, replica set as a whole.
+func ddsResizeTargets(instance *instances.InstanceResponse, flavorType, specCode string) []ResizeOpts {
+	if flavorType == "replica" {
+		return []ResizeOpts{{TargetID: instance.Id, TargetSpecCode: specCode}}
+	}
+
+	var targets []ResizeOpts
+	for _, group := range instance.Groups {
+		if group.Type != flavorType {
+			continue
+		}
+		if flavorType == "mongos" {
+			for _, node := range group.Nodes {
+				targets = append(targets, ResizeOpts{TargetType: flavorType, TargetID: node.Id, TargetSpecCode: specCode})
+			}
+			continue
+		}
+		targets = append(targets, ResizeOpts{TargetType: flavorType, TargetID: group.Id, TargetSpecCode: specCode})
+	}
+	return targets
 }
 
 func resourceDdsInstanceV3Delete(d *schema.ResourceData, meta interface{}) error {
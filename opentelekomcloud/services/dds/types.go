@@ -0,0 +1,134 @@
+package dds
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// JobResult represents the result of the asynchronous DDS instance operation.
+type JobResult struct {
+	golangsdk.Result
+}
+
+// ExtractJobID extracts the ID of the job created by the DDS instance operation.
+func (r JobResult) ExtractJobID() (string, error) {
+	var s struct {
+		JobID string `json:"job_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.JobID, err
+}
+
+// Job represents the DDS task information.
+type Job struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Fail   string `json:"fail_reason"`
+}
+
+// GetJob retrieves the DDS task information.
+func GetJob(client *golangsdk.ServiceClient, jobID string) (*Job, error) {
+	var r golangsdk.Result
+	url := client.ServiceURL("jobs") + "?id=" + jobID
+	_, r.Err = client.Get(url, &r.Body, nil)
+
+	var s struct {
+		Job Job `json:"job"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Job, err
+}
+
+// VolumeOpts represents the storage space of the DDS instance nodes.
+type VolumeOpts struct {
+	GroupID string `json:"group_id,omitempty"`
+	Size    string `json:"size" required:"true"`
+}
+
+// EnlargeOpts represents the attributes used when adding nodes to the DDS cluster instance.
+type EnlargeOpts struct {
+	Type     string      `json:"type" required:"true"`
+	SpecCode string      `json:"spec_code" required:"true"`
+	Num      int         `json:"num" required:"true"`
+	Volume   *VolumeOpts `json:"volume,omitempty"`
+}
+
+// ToEnlargeMap builds a request body from EnlargeOpts.
+func (opts EnlargeOpts) ToEnlargeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Enlarge adds mongos or shard nodes to the DDS cluster instance.
+func Enlarge(client *golangsdk.ServiceClient, instanceID string, opts EnlargeOpts) (r JobResult) {
+	b, err := opts.ToEnlargeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("instances", instanceID, "enlarge"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// ResizeOpts represents the attributes used when changing the DDS instance node specifications.
+type ResizeOpts struct {
+	TargetType     string `json:"target_type,omitempty"`
+	TargetID       string `json:"target_id" required:"true"`
+	TargetSpecCode string `json:"target_spec_code" required:"true"`
+}
+
+// ToResizeMap builds a request body from ResizeOpts.
+func (opts ResizeOpts) ToResizeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "resize")
+}
+
+// Resize changes the specifications of the DDS instance nodes.
+func Resize(client *golangsdk.ServiceClient, instanceID string, opts ResizeOpts) (r JobResult) {
+	b, err := opts.ToResizeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("instances", instanceID, "resize"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// EnlargeVolume expands the storage space of the DDS instance nodes.
+func EnlargeVolume(client *golangsdk.ServiceClient, instanceID string, opts VolumeOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "volume")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("instances", instanceID, "enlarge-volume"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// BackupPolicyOpts represents the attributes used when changing the automated backup policy.
+type BackupPolicyOpts struct {
+	StartTime string `json:"start_time" required:"true"`
+	KeepDays  *int   `json:"keep_days" required:"true"`
+}
+
+// ToBackupPolicyMap builds a request body from BackupPolicyOpts.
+func (opts BackupPolicyOpts) ToBackupPolicyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "backup_policy")
+}
+
+// UpdateBackupPolicy changes the automated backup policy of the DDS instance.
+func UpdateBackupPolicy(client *golangsdk.ServiceClient, instanceID string, opts BackupPolicyOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToBackupPolicyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("instances", instanceID, "backups", "policy"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
@@ -0,0 +1,219 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v1/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsTopicsV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsTopicV1Create,
+		Read:   resourceDmsTopicV1Read,
+		Update: resourceDmsTopicV1Update,
+		Delete: resourceDmsTopicV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDmsTopicV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 200),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`),
+						"only letters, digits, periods (.), hyphens (-), and underscores (_) are allowed"),
+				),
+			},
+			"partition": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"replication": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+			"retention_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      72,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+			"sync_replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"sync_message_flush": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceDmsTopicV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv1 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	instance, err := instances.Get(client, instanceID).Extract()
+	if err != nil {
+		return fmt.Errorf("error getting DMS instance %s: %s", instanceID, err)
+	}
+	if instance.Engine != "kafka" {
+		return fmt.Errorf("topics are supported only by Kafka DMS instances, got: %s", instance.Engine)
+	}
+
+	createOpts := TopicCreateOpts{
+		Name:             d.Get("name").(string),
+		Partition:        d.Get("partition").(int),
+		Replication:      d.Get("replication").(int),
+		RetentionTime:    d.Get("retention_time").(int),
+		SyncReplication:  d.Get("sync_replication").(bool),
+		SyncMessageFlush: d.Get("sync_message_flush").(bool),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	if err := CreateTopic(client, instanceID, createOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS topic: %s", err)
+	}
+
+	d.SetId(createOpts.Name)
+
+	return resourceDmsTopicV1Read(d, meta)
+}
+
+func getDmsTopic(client *golangsdk.ServiceClient, instanceID, name string) (*Topic, error) {
+	topicList, err := ListTopics(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, topic := range topicList.Topics {
+		if topic.Name == name {
+			return &topic, nil
+		}
+	}
+	return nil, nil
+}
+
+func resourceDmsTopicV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv1 client: %s", err)
+	}
+
+	topic, err := getDmsTopic(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error reading DMS topic")
+	}
+	if topic == nil {
+		log.Printf("[WARN] DMS topic %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Dms topic %s: %+v", d.Id(), topic)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", topic.Name),
+		d.Set("partition", topic.Partition),
+		d.Set("replication", topic.Replication),
+		d.Set("retention_time", topic.RetentionTime),
+		d.Set("sync_replication", topic.SyncReplication),
+		d.Set("sync_message_flush", topic.SyncMessageFlush),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceDmsTopicV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv1 client: %s", err)
+	}
+
+	topic := TopicUpdateItem{
+		Name: d.Id(),
+	}
+	if d.HasChange("partition") {
+		oldPartition, newPartition := d.GetChange("partition")
+		if newPartition.(int) < oldPartition.(int) {
+			return fmt.Errorf("the number of topic partitions can only be increased")
+		}
+		topic.Partition = newPartition.(int)
+	}
+	if d.HasChange("retention_time") {
+		topic.RetentionTime = d.Get("retention_time").(int)
+	}
+
+	updateOpts := TopicUpdateOpts{
+		Topics: []TopicUpdateItem{topic},
+	}
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	if err := UpdateTopics(client, d.Get("instance_id").(string), updateOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error updating OpenTelekomCloud DMS topic: %s", err)
+	}
+
+	return resourceDmsTopicV1Read(d, meta)
+}
+
+func resourceDmsTopicV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv1 client: %s", err)
+	}
+
+	err = DeleteTopics(client, d.Get("instance_id").(string), []string{d.Id()}).ExtractErr()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DMS topic")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsTopicV1Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("invalid format specified for DMS topic. Format must be <instance id>/<topic name>")
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("instance_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,104 @@
+package dms
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// TopicCreateOpts represents the attributes used when creating a new Kafka topic.
+type TopicCreateOpts struct {
+	Name             string `json:"id" required:"true"`
+	Partition        int    `json:"partition,omitempty"`
+	Replication      int    `json:"replication,omitempty"`
+	RetentionTime    int    `json:"retention_time,omitempty"`
+	SyncReplication  bool   `json:"sync_replication,omitempty"`
+	SyncMessageFlush bool   `json:"sync_message_flush,omitempty"`
+}
+
+// ToTopicCreateMap builds a request body from TopicCreateOpts.
+func (opts TopicCreateOpts) ToTopicCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// TopicUpdateItem represents the attributes of a single topic used when updating Kafka topics.
+type TopicUpdateItem struct {
+	Name          string `json:"id" required:"true"`
+	Partition     int    `json:"new_partition_numbers,omitempty"`
+	RetentionTime int    `json:"retention_time,omitempty"`
+}
+
+// TopicUpdateOpts represents the attributes used when updating Kafka topics.
+type TopicUpdateOpts struct {
+	Topics []TopicUpdateItem `json:"topics" required:"true"`
+}
+
+// ToTopicUpdateMap builds a request body from TopicUpdateOpts.
+func (opts TopicUpdateOpts) ToTopicUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Topic represents a Kafka topic of the DMS instance.
+type Topic struct {
+	Name             string `json:"name"`
+	Partition        int    `json:"partition"`
+	Replication      int    `json:"replication"`
+	RetentionTime    int    `json:"retention_time"`
+	SyncReplication  bool   `json:"sync_replication"`
+	SyncMessageFlush bool   `json:"sync_message_flush"`
+}
+
+// TopicList represents Kafka topics of the DMS instance.
+type TopicList struct {
+	Total            int     `json:"total"`
+	RemainPartitions int     `json:"remain_partitions"`
+	MaxPartitions    int     `json:"max_partitions"`
+	Topics           []Topic `json:"topics"`
+}
+
+func topicsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("instances", instanceID, "topics")
+}
+
+// CreateTopic creates a new Kafka topic on the DMS instance.
+func CreateTopic(client *golangsdk.ServiceClient, instanceID string, opts TopicCreateOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToTopicCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(topicsURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateTopics updates partitions and retention time of Kafka topics on the DMS instance.
+func UpdateTopics(client *golangsdk.ServiceClient, instanceID string, opts TopicUpdateOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToTopicUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(topicsURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// ListTopics lists all Kafka topics of the DMS instance.
+func ListTopics(client *golangsdk.ServiceClient, instanceID string) (*TopicList, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(topicsURL(client, instanceID), &r.Body, nil)
+
+	var s TopicList
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// DeleteTopics deletes Kafka topics from the DMS instance.
+func DeleteTopics(client *golangsdk.ServiceClient, instanceID string, names []string) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{"topics": names}
+	_, r.Err = client.Post(client.ServiceURL("instances", instanceID, "topics", "delete"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
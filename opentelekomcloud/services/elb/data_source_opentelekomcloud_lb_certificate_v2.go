@@ -0,0 +1,121 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/certificates"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceCertificateV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCertificateV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"server", "client"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCertificateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	listOpts := certificates.ListOpts{
+		ID:     d.Get("id").(string),
+		Name:   d.Get("name").(string),
+		Domain: d.Get("domain").(string),
+		Type:   d.Get("type").(string),
+	}
+	pages, err := certificates.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve certificates: %s", err)
+	}
+	allCertificates, err := certificates.ExtractCertificates(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract certificates: %s", err)
+	}
+
+	if len(allCertificates) < 1 {
+		return fmt.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+	if len(allCertificates) > 1 {
+		return fmt.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	c := allCertificates[0]
+	log.Printf("[DEBUG] Retrieved certificate %s", c.ID)
+	d.SetId(c.ID)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", c.Name),
+		d.Set("description", c.Description),
+		d.Set("domain", c.Domain),
+		d.Set("type", c.Type),
+		d.Set("certificate", c.Certificate),
+		d.Set("expire_time", c.ExpireTime),
+		d.Set("create_time", c.CreateTime),
+		d.Set("update_time", c.UpdateTime),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting certificate fields: %s", err)
+	}
+
+	return nil
+}
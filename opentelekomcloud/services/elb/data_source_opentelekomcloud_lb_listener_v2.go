@@ -0,0 +1,179 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceListenerV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceListenerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"default_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": common.TagsSchemaComputed(),
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http2_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"default_tls_container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sni_container_refs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tls_ciphers_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	listOpts := listeners.ListOpts{
+		ID:             d.Get("id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Protocol:       d.Get("protocol").(string),
+		ProtocolPort:   d.Get("protocol_port").(int),
+		DefaultPoolID:  d.Get("default_pool_id").(string),
+	}
+	pages, err := listeners.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve listeners: %s", err)
+	}
+	allListeners, err := listeners.ExtractListeners(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract listeners: %s", err)
+	}
+
+	// tags are fetched for every candidate only when filtering by them
+	found := allListeners
+	var foundTags map[string]string
+	if expectedTags := d.Get("tags").(map[string]interface{}); len(expectedTags) > 0 {
+		found = nil
+		for _, listener := range allListeners {
+			tagMap, ok, err := common.GetResourceTagsMatch(client, "listeners", listener.ID, expectedTags)
+			if err != nil {
+				return err
+			}
+			if ok {
+				found = append(found, listener)
+				foundTags = tagMap
+			}
+		}
+	}
+
+	if len(found) < 1 {
+		return fmt.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+	if len(found) > 1 {
+		return fmt.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	listener := found[0]
+	log.Printf("[DEBUG] Retrieved listener %s: %#v", listener.ID, listener)
+	d.SetId(listener.ID)
+
+	if foundTags == nil {
+		tagMap, _, err := common.GetResourceTagsMatch(client, "listeners", listener.ID, nil)
+		if err != nil {
+			return err
+		}
+		foundTags = tagMap
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", listener.Name),
+		d.Set("description", listener.Description),
+		d.Set("protocol", listener.Protocol),
+		d.Set("protocol_port", listener.ProtocolPort),
+		d.Set("default_pool_id", listener.DefaultPoolID),
+		d.Set("tenant_id", listener.TenantID),
+		d.Set("http2_enable", listener.Http2Enable),
+		d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef),
+		d.Set("client_ca_tls_container_ref", listener.CAContainerRef),
+		d.Set("sni_container_refs", listener.SniContainerRefs),
+		d.Set("tls_ciphers_policy", listener.TlsCiphersPolicy),
+		d.Set("admin_state_up", listener.AdminStateUp),
+		d.Set("tags", foundTags),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if len(listener.Loadbalancers) > 0 {
+		mErr = multierror.Append(mErr, d.Set("loadbalancer_id", listener.Loadbalancers[0].ID))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting listener fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceLoadBalancerV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLoadBalancerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vip_subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": common.TagsSchemaComputed(),
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"loadbalancer_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	listOpts := loadbalancers.ListOpts{
+		ID:          d.Get("id").(string),
+		Name:        d.Get("name").(string),
+		VipAddress:  d.Get("vip_address").(string),
+		VipSubnetID: d.Get("vip_subnet_id").(string),
+	}
+	pages, err := loadbalancers.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve loadbalancers: %s", err)
+	}
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract loadbalancers: %s", err)
+	}
+
+	// tags are fetched for every candidate only when filtering by them
+	found := allLoadBalancers
+	var foundTags map[string]string
+	if expectedTags := d.Get("tags").(map[string]interface{}); len(expectedTags) > 0 {
+		found = nil
+		for _, lb := range allLoadBalancers {
+			tagMap, ok, err := common.GetResourceTagsMatch(client, "loadbalancers", lb.ID, expectedTags)
+			if err != nil {
+				return err
+			}
+			if ok {
+				found = append(found, lb)
+				foundTags = tagMap
+			}
+		}
+	}
+
+	if len(found) < 1 {
+		return fmt.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+	if len(found) > 1 {
+		return fmt.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	lb := found[0]
+	log.Printf("[DEBUG] Retrieved loadbalancer %s: %#v", lb.ID, lb)
+	d.SetId(lb.ID)
+
+	if foundTags == nil {
+		tagMap, _, err := common.GetResourceTagsMatch(client, "loadbalancers", lb.ID, nil)
+		if err != nil {
+			return err
+		}
+		foundTags = tagMap
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", lb.Name),
+		d.Set("description", lb.Description),
+		d.Set("vip_address", lb.VipAddress),
+		d.Set("vip_subnet_id", lb.VipSubnetID),
+		d.Set("vip_port_id", lb.VipPortID),
+		d.Set("tenant_id", lb.TenantID),
+		d.Set("admin_state_up", lb.AdminStateUp),
+		d.Set("loadbalancer_provider", lb.Provider),
+		d.Set("tags", foundTags),
+		d.Set("region", config.GetRegion(d)),
+	)
+
+	if lb.VipPortID != "" {
+		port, err := ports.Get(client, lb.VipPortID).Extract()
+		if err != nil {
+			return fmt.Errorf("error fetching VIP port of loadbalancer %s: %s", lb.ID, err)
+		}
+		mErr = multierror.Append(mErr, d.Set("security_group_ids", port.SecurityGroups))
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting loadbalancer fields: %s", err)
+	}
+
+	return nil
+}
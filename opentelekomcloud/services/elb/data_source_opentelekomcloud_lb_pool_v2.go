@@ -0,0 +1,169 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourcePoolV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePoolV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"lb_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"persistence": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"member_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	listOpts := pools.ListOpts{
+		ID:             d.Get("id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		ListenerID:     d.Get("listener_id").(string),
+		Protocol:       d.Get("protocol").(string),
+		LBMethod:       d.Get("lb_method").(string),
+	}
+	pages, err := pools.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve pools: %s", err)
+	}
+	allPools, err := pools.ExtractPools(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract pools: %s", err)
+	}
+
+	if len(allPools) < 1 {
+		return fmt.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+	if len(allPools) > 1 {
+		return fmt.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	pool := allPools[0]
+	log.Printf("[DEBUG] Retrieved pool %s: %#v", pool.ID, pool)
+	d.SetId(pool.ID)
+
+	var persistence []map[string]interface{}
+	if pool.Persistence.Type != "" {
+		persistence = []map[string]interface{}{
+			{
+				"type":        pool.Persistence.Type,
+				"cookie_name": pool.Persistence.CookieName,
+			},
+		}
+	}
+	memberIDs := make([]string, len(pool.Members))
+	for i, member := range pool.Members {
+		memberIDs[i] = member.ID
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", pool.Name),
+		d.Set("description", pool.Description),
+		d.Set("protocol", pool.Protocol),
+		d.Set("lb_method", pool.LBMethod),
+		d.Set("tenant_id", pool.TenantID),
+		d.Set("admin_state_up", pool.AdminStateUp),
+		d.Set("monitor_id", pool.MonitorID),
+		d.Set("persistence", persistence),
+		d.Set("member_ids", memberIDs),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if len(pool.Loadbalancers) > 0 {
+		mErr = multierror.Append(mErr, d.Set("loadbalancer_id", pool.Loadbalancers[0].ID))
+	}
+	if len(pool.Listeners) > 0 {
+		mErr = multierror.Append(mErr, d.Set("listener_id", pool.Listeners[0].ID))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting pool fields: %s", err)
+	}
+
+	return nil
+}
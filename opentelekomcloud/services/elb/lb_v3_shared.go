@@ -0,0 +1,91 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// lbV3PendingDeleteStatuses are the valid statuses a dedicated LoadBalancer will be in while
+// it's deleting.
+var lbV3PendingDeleteStatuses = []string{"ACTIVE", "PENDING_DELETE"}
+
+// waitForLBV3LoadBalancer waits for the dedicated load balancer provisioning status.
+// All changes of the load balancer child resources are applied while the load balancer
+// stays in the PENDING_UPDATE state, so it's also used to wait for them.
+func waitForLBV3LoadBalancer(client *golangsdk.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for loadbalancer %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV3LoadBalancerRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for loadbalancer %s to become %s: %s", id, target, err)
+	}
+
+	return nil
+}
+
+func resourceLBV3LoadBalancerRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		lb, err := GetLoadBalancerV3(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return lb, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		if lb.ProvisioningStatus == "ERROR" {
+			return lb, lb.ProvisioningStatus, fmt.Errorf("loadbalancer is in error state")
+		}
+
+		return lb, lb.ProvisioningStatus, nil
+	}
+}
+
+// getLBV3IDByPool returns the ID of the dedicated load balancer the pool belongs to
+func getLBV3IDByPool(client *golangsdk.ServiceClient, poolID string) (string, error) {
+	pool, err := GetPoolV3(client, poolID)
+	if err != nil {
+		return "", err
+	}
+	if len(pool.Loadbalancers) > 0 {
+		return pool.Loadbalancers[0].ID, nil
+	}
+	if len(pool.Listeners) == 0 {
+		return "", fmt.Errorf("unable to determine loadbalancer ID of pool %s", poolID)
+	}
+
+	listener, err := GetListenerV3(client, pool.Listeners[0].ID)
+	if err != nil {
+		return "", err
+	}
+	if len(listener.Loadbalancers) == 0 {
+		return "", fmt.Errorf("unable to determine loadbalancer ID of listener %s", listener.ID)
+	}
+	return listener.Loadbalancers[0].ID, nil
+}
+
+// lbV3TagsClient returns the client of the ELB tags API, which has no v3 version
+func lbV3TagsClient(client *golangsdk.ServiceClient) *golangsdk.ServiceClient {
+	tagsClient := *client
+	tagsClient.ResourceBase = fmt.Sprintf("%sv2.0/%s/", client.Endpoint, client.ProjectID)
+	return &tagsClient
+}
+
+func flattenLBV3IDs(refs []ResourceRef) []string {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+	return ids
+}
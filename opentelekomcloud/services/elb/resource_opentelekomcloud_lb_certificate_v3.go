@@ -0,0 +1,205 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCertificateV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCertificateV3Create,
+		Read:   resourceCertificateV3Read,
+		Update: resourceCertificateV3Update,
+		Delete: resourceCertificateV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "server",
+				ValidateFunc: validation.StringInSlice([]string{"server", "client"}, false),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressPEMDiffs,
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressPEMDiffs,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressPEMDiffs ignores leading and trailing whitespace of the PEM encoded values
+func suppressPEMDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func resourceCertificateV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	createOpts := CertificateV3CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		Domain:      d.Get("domain").(string),
+		PrivateKey:  d.Get("private_key").(string),
+		Certificate: d.Get("certificate").(string),
+	}
+	if createOpts.Type == "server" && createOpts.PrivateKey == "" {
+		return fmt.Errorf("private_key is required for the `server` certificate")
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	certificate, err := CreateCertificateV3(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating CertificateV3: %s", err)
+	}
+	d.SetId(certificate.ID)
+
+	return resourceCertificateV3Read(d, meta)
+}
+
+func resourceCertificateV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	certificate, err := GetCertificateV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "certificateV3")
+	}
+	log.Printf("[DEBUG] Retrieved certificate %s", d.Id())
+
+	mErr := multierror.Append(nil,
+		d.Set("name", certificate.Name),
+		d.Set("description", certificate.Description),
+		d.Set("type", certificate.Type),
+		d.Set("domain", certificate.Domain),
+		d.Set("certificate", certificate.Certificate),
+		d.Set("expire_time", certificate.ExpireTime),
+		d.Set("created_at", certificate.CreatedAt),
+		d.Set("updated_at", certificate.UpdatedAt),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if certificate.PrivateKey != "" {
+		mErr = multierror.Append(mErr, d.Set("private_key", certificate.PrivateKey))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CertificateV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCertificateV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	var updateOpts CertificateV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("domain") {
+		updateOpts.Domain = d.Get("domain").(string)
+	}
+	if d.HasChange("private_key") {
+		updateOpts.PrivateKey = d.Get("private_key").(string)
+	}
+	if d.HasChange("certificate") {
+		updateOpts.Certificate = d.Get("certificate").(string)
+	}
+
+	log.Printf("[DEBUG] Updating certificate %s", d.Id())
+	if _, err := UpdateCertificateV3(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("unable to update CertificateV3 %s: %s", d.Id(), err)
+	}
+
+	return resourceCertificateV3Read(d, meta)
+}
+
+func resourceCertificateV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting certificate %s", d.Id())
+	if err := DeleteCertificateV3(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting CertificateV3")
+	}
+
+	d.SetId("")
+	return nil
+}
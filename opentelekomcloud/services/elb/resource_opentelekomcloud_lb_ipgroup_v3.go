@@ -0,0 +1,204 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIPGroupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIPGroupV3Create,
+		Read:   resourceIPGroupV3Read,
+		Update: resourceIPGroupV3Update,
+		Delete: resourceIPGroupV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ip_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.IsIPAddress,
+								validation.IsCIDR,
+							),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"listener_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getIPGroupV3IPList(d *schema.ResourceData) []IPGroupV3IP {
+	ipListRaw := d.Get("ip_list").([]interface{})
+	ipList := make([]IPGroupV3IP, len(ipListRaw))
+	for i, v := range ipListRaw {
+		ip := v.(map[string]interface{})
+		ipList[i] = IPGroupV3IP{
+			IP:          ip["ip"].(string),
+			Description: ip["description"].(string),
+		}
+	}
+	return ipList
+}
+
+func resourceIPGroupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	createOpts := IPGroupV3CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IPList:      getIPGroupV3IPList(d),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	ipGroup, err := CreateIPGroupV3(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating IPGroupV3: %s", err)
+	}
+	d.SetId(ipGroup.ID)
+
+	return resourceIPGroupV3Read(d, meta)
+}
+
+func resourceIPGroupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	ipGroup, err := GetIPGroupV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "ipGroupV3")
+	}
+	log.Printf("[DEBUG] Retrieved IP group %s: %#v", d.Id(), ipGroup)
+
+	ipList := make([]map[string]interface{}, len(ipGroup.IPList))
+	for i, ip := range ipGroup.IPList {
+		ipList[i] = map[string]interface{}{
+			"ip":          ip.IP,
+			"description": ip.Description,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", ipGroup.Name),
+		d.Set("description", ipGroup.Description),
+		d.Set("ip_list", ipList),
+		d.Set("listener_ids", flattenLBV3IDs(ipGroup.Listeners)),
+		d.Set("created_at", ipGroup.CreatedAt),
+		d.Set("updated_at", ipGroup.UpdatedAt),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting IPGroupV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIPGroupV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	var updateOpts IPGroupV3UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("ip_list") {
+		ipList := getIPGroupV3IPList(d)
+		updateOpts.IPList = &ipList
+	}
+
+	log.Printf("[DEBUG] Updating IP group %s with options: %#v", d.Id(), updateOpts)
+	if _, err := UpdateIPGroupV3(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("unable to update IPGroupV3 %s: %s", d.Id(), err)
+	}
+
+	return resourceIPGroupV3Read(d, meta)
+}
+
+func resourceIPGroupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting IP group %s", d.Id())
+	if err := DeleteIPGroupV3(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting IPGroupV3")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -3,6 +3,7 @@ package elb
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -23,6 +24,9 @@ func ResourceL7PolicyV2() *schema.Resource {
 		Read:   resourceL7PolicyV2Read,
 		Update: resourceL7PolicyV2Update,
 		Delete: resourceL7PolicyV2Delete,
+
+		CustomizeDiff: validateL7PolicyV2Action,
+
 		Importer: &schema.ResourceImporter{
 			State: resourceL7PolicyV2Import,
 		},
@@ -61,9 +65,8 @@ func ResourceL7PolicyV2() *schema.Resource {
 			"action": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER",
+					"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER", "REDIRECT_TO_URL", "FIXED_RESPONSE",
 				}, true),
 			},
 
@@ -92,6 +95,81 @@ func ResourceL7PolicyV2() *schema.Resource {
 				Optional:      true,
 			},
 
+			"redirect_url_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "${protocol}",
+							ValidateFunc: validation.StringInSlice([]string{
+								"${protocol}", "HTTP", "HTTPS",
+							}, false),
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "${host}",
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "${port}",
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "${path}",
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "${query}",
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"301", "302", "303", "307", "308",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"fixed_response_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[245]\d{2}$`), "status code should be 2xx, 4xx or 5xx",
+							),
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "text/plain",
+							ValidateFunc: validation.StringInSlice([]string{
+								"text/plain", "text/css", "text/html", "application/javascript", "application/json",
+							}, false),
+						},
+						"message_body": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+
 			"admin_state_up": {
 				Type:         schema.TypeBool,
 				Default:      true,
@@ -122,15 +200,19 @@ func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := l7policies.CreateOpts{
-		TenantID:           d.Get("tenant_id").(string),
-		Name:               d.Get("name").(string),
-		Description:        d.Get("description").(string),
-		Action:             l7policies.Action(action),
-		ListenerID:         listenerID,
-		RedirectPoolID:     redirectPoolID,
-		RedirectListenerID: redirectListenerID,
-		AdminStateUp:       &adminStateUp,
+	createOpts := L7PolicyV2CreateOpts{
+		CreateOpts: l7policies.CreateOpts{
+			TenantID:           d.Get("tenant_id").(string),
+			Name:               d.Get("name").(string),
+			Description:        d.Get("description").(string),
+			Action:             l7policies.Action(action),
+			ListenerID:         listenerID,
+			RedirectPoolID:     redirectPoolID,
+			RedirectListenerID: redirectListenerID,
+			AdminStateUp:       &adminStateUp,
+		},
+		RedirectURLConfig:   getL7PolicyV2RedirectURLConfig(d),
+		FixedResponseConfig: getL7PolicyV2FixedResponseConfig(d),
 	}
 
 	if v, ok := d.GetOk("position"); ok {
@@ -198,13 +280,39 @@ func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	l7Policy, err := l7policies.Get(lbClient, d.Id()).Extract()
+	l7Policy, err := GetL7PolicyV2(lbClient, d.Id())
 	if err != nil {
 		return common.CheckDeleted(d, err, "L7 Policy")
 	}
 
 	log.Printf("[DEBUG] Retrieved L7 Policy %s: %#v", d.Id(), l7Policy)
 
+	var redirectURLConfig []map[string]interface{}
+	if c := l7Policy.RedirectURLConfig; c != nil && c.StatusCode != "" {
+		redirectURLConfig = []map[string]interface{}{
+			{
+				"protocol":    c.Protocol,
+				"host":        c.Host,
+				"port":        c.Port,
+				"path":        c.Path,
+				"query":       c.Query,
+				"status_code": c.StatusCode,
+			},
+		}
+	}
+	var fixedResponseConfig []map[string]interface{}
+	if c := l7Policy.FixedResponseConfig; c != nil && c.StatusCode != "" {
+		fixedResponseConfig = []map[string]interface{}{
+			{
+				"status_code":  c.StatusCode,
+				"content_type": c.ContentType,
+				"message_body": c.MessageBody,
+			},
+		}
+	}
+	d.Set("redirect_url_config", redirectURLConfig)
+	d.Set("fixed_response_config", fixedResponseConfig)
+
 	d.Set("action", l7Policy.Action)
 	d.Set("description", l7Policy.Description)
 	d.Set("tenant_id", l7Policy.TenantID)
@@ -231,8 +339,11 @@ func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectListenerID := d.Get("redirect_listener_id").(string)
 
-	var updateOpts l7policies.UpdateOpts
+	var updateOpts L7PolicyV2UpdateOpts
 
+	if d.HasChange("action") {
+		updateOpts.Action = l7policies.Action(action)
+	}
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
 		updateOpts.Name = &name
@@ -246,6 +357,17 @@ func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
 
 		updateOpts.RedirectPoolID = &redirectPoolID
 	}
+	if d.HasChange("redirect_listener_id") {
+		redirectListenerID = d.Get("redirect_listener_id").(string)
+
+		updateOpts.RedirectListenerID = &redirectListenerID
+	}
+	if d.HasChange("action") || d.HasChange("redirect_url_config") {
+		updateOpts.RedirectURLConfig = getL7PolicyV2RedirectURLConfig(d)
+	}
+	if d.HasChange("action") || d.HasChange("fixed_response_config") {
+		updateOpts.FixedResponseConfig = getL7PolicyV2FixedResponseConfig(d)
+	}
 	if d.HasChange("admin_state_up") {
 		adminStateUp := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &adminStateUp
@@ -395,13 +517,68 @@ func resourceL7PolicyV2Import(d *schema.ResourceData, meta interface{}) ([]*sche
 }
 
 func checkL7PolicyAction(action, redirectListenerID, redirectPoolID string) error {
-	if action == "REDIRECT_TO_POOL" && redirectListenerID != "" {
+	if action != "REDIRECT_TO_LISTENER" && redirectListenerID != "" {
 		return fmt.Errorf("redirect_listener_id must be empty when action is set to %s", action)
 	}
 
-	if action == "REDIRECT_TO_LISTENER" && redirectPoolID != "" {
+	if action != "REDIRECT_TO_POOL" && redirectPoolID != "" {
 		return fmt.Errorf("redirect_pool_id must be empty when action is set to %s", action)
 	}
 
 	return nil
 }
+
+// validateL7PolicyV2Action checks that only the options of the selected action are set
+func validateL7PolicyV2Action(d *schema.ResourceDiff, _ interface{}) error {
+	action := d.Get("action").(string)
+	if err := checkL7PolicyAction(action, d.Get("redirect_listener_id").(string), d.Get("redirect_pool_id").(string)); err != nil {
+		return err
+	}
+
+	_, hasRedirectURL := d.GetOk("redirect_url_config")
+	if action == "REDIRECT_TO_URL" && !hasRedirectURL {
+		return fmt.Errorf("redirect_url_config must be set when action is set to %s", action)
+	}
+	if action != "REDIRECT_TO_URL" && hasRedirectURL {
+		return fmt.Errorf("redirect_url_config must be empty when action is set to %s", action)
+	}
+
+	_, hasFixedResponse := d.GetOk("fixed_response_config")
+	if action == "FIXED_RESPONSE" && !hasFixedResponse {
+		return fmt.Errorf("fixed_response_config must be set when action is set to %s", action)
+	}
+	if action != "FIXED_RESPONSE" && hasFixedResponse {
+		return fmt.Errorf("fixed_response_config must be empty when action is set to %s", action)
+	}
+
+	return nil
+}
+
+func getL7PolicyV2RedirectURLConfig(d *schema.ResourceData) *L7PolicyV2RedirectURLConfig {
+	configRaw := d.Get("redirect_url_config").([]interface{})
+	if len(configRaw) == 0 || configRaw[0] == nil {
+		return nil
+	}
+	config := configRaw[0].(map[string]interface{})
+	return &L7PolicyV2RedirectURLConfig{
+		Protocol:   config["protocol"].(string),
+		Host:       config["host"].(string),
+		Port:       config["port"].(string),
+		Path:       config["path"].(string),
+		Query:      config["query"].(string),
+		StatusCode: config["status_code"].(string),
+	}
+}
+
+func getL7PolicyV2FixedResponseConfig(d *schema.ResourceData) *L7PolicyV2FixedResponseConfig {
+	configRaw := d.Get("fixed_response_config").([]interface{})
+	if len(configRaw) == 0 || configRaw[0] == nil {
+		return nil
+	}
+	config := configRaw[0].(map[string]interface{})
+	return &L7PolicyV2FixedResponseConfig{
+		StatusCode:  config["status_code"].(string),
+		ContentType: config["content_type"].(string),
+		MessageBody: config["message_body"].(string),
+	}
+}
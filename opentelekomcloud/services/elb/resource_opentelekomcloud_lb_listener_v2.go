@@ -24,6 +24,8 @@ func ResourceListenerV2() *schema.Resource {
 		Update: resourceListenerV2Update,
 		Delete: resourceListenerV2Delete,
 
+		CustomizeDiff: validateListenerV2Protocol,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -112,11 +114,103 @@ func ResourceListenerV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+			"transparent_client_ip_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"keepalive_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 4000),
+			},
+			"client_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"member_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"insert_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forwarded_elb_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_host": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"tags": common.TagsSchema(),
 		},
 	}
 }
 
+// validateListenerV2Protocol checks that the listener options are supported by the listener protocol
+func validateListenerV2Protocol(d *schema.ResourceDiff, _ interface{}) error {
+	protocol := d.Get("protocol").(string)
+	isHTTP := protocol == "HTTP" || protocol == "TERMINATED_HTTPS"
+
+	// timeouts are computed and returned by the API for any protocol,
+	// so only the values being created or changed are checked
+	configured := func(key string) bool {
+		if d.Id() != "" && !d.HasChange(key) {
+			return false
+		}
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	mErr := &multierror.Error{}
+	if d.Get("http2_enable").(bool) && protocol != "TERMINATED_HTTPS" {
+		mErr = multierror.Append(mErr, fmt.Errorf("http2_enable can only be used with TERMINATED_HTTPS listener"))
+	}
+	if !isHTTP {
+		for _, key := range []string{"client_timeout", "member_timeout", "insert_headers"} {
+			if configured(key) {
+				mErr = multierror.Append(mErr, fmt.Errorf("%s can only be used with HTTP or TERMINATED_HTTPS listener", key))
+			}
+		}
+	}
+	if configured("keepalive_timeout") {
+		switch {
+		case protocol == "UDP":
+			mErr = multierror.Append(mErr, fmt.Errorf("keepalive_timeout can't be used with UDP listener"))
+		case protocol == "TCP" && d.Get("keepalive_timeout").(int) < 10:
+			mErr = multierror.Append(mErr, fmt.Errorf("keepalive_timeout of TCP listener should be [10, 4000]"))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func getListenerV2InsertHeaders(d *schema.ResourceData) *ListenerV2InsertHeaders {
+	headersRaw := d.Get("insert_headers").([]interface{})
+	if len(headersRaw) == 0 || headersRaw[0] == nil {
+		return nil
+	}
+	headers := headersRaw[0].(map[string]interface{})
+	forwardedELBIP := headers["forwarded_elb_ip"].(bool)
+	forwardedHost := headers["forwarded_host"].(bool)
+	return &ListenerV2InsertHeaders{
+		ForwardedELBIP: &forwardedELBIP,
+		ForwardedHost:  &forwardedHost,
+	}
+}
+
 func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.NetworkingV2Client(config.GetRegion(d))
@@ -132,20 +226,39 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 			sniContainerRefs = append(sniContainerRefs, v.(string))
 		}
 	}
-	createOpts := listeners.CreateOpts{
-		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
-		ProtocolPort:           d.Get("protocol_port").(int),
-		TenantID:               d.Get("tenant_id").(string),
-		LoadbalancerID:         d.Get("loadbalancer_id").(string),
-		Name:                   d.Get("name").(string),
-		DefaultPoolID:          d.Get("default_pool_id").(string),
-		Description:            d.Get("description").(string),
-		Http2Enable:            &http2Enable,
-		DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
-		CAContainerRef:         d.Get("client_ca_tls_container_ref").(string),
-		SniContainerRefs:       sniContainerRefs,
-		TlsCiphersPolicy:       d.Get("tls_ciphers_policy").(string),
-		AdminStateUp:           &adminStateUp,
+	createOpts := ListenerV2CreateOpts{
+		CreateOpts: listeners.CreateOpts{
+			Protocol:               listeners.Protocol(d.Get("protocol").(string)),
+			ProtocolPort:           d.Get("protocol_port").(int),
+			TenantID:               d.Get("tenant_id").(string),
+			LoadbalancerID:         d.Get("loadbalancer_id").(string),
+			Name:                   d.Get("name").(string),
+			DefaultPoolID:          d.Get("default_pool_id").(string),
+			Description:            d.Get("description").(string),
+			Http2Enable:            &http2Enable,
+			DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
+			CAContainerRef:         d.Get("client_ca_tls_container_ref").(string),
+			SniContainerRefs:       sniContainerRefs,
+			TlsCiphersPolicy:       d.Get("tls_ciphers_policy").(string),
+			AdminStateUp:           &adminStateUp,
+		},
+		InsertHeaders: getListenerV2InsertHeaders(d),
+	}
+	if v, ok := d.GetOkExists("transparent_client_ip_enable"); ok {
+		transparentClientIP := v.(bool)
+		createOpts.TransparentClientIPEnable = &transparentClientIP
+	}
+	if v, ok := d.GetOk("keepalive_timeout"); ok {
+		keepaliveTimeout := v.(int)
+		createOpts.KeepaliveTimeout = &keepaliveTimeout
+	}
+	if v, ok := d.GetOk("client_timeout"); ok {
+		clientTimeout := v.(int)
+		createOpts.ClientTimeout = &clientTimeout
+	}
+	if v, ok := d.GetOk("member_timeout"); ok {
+		memberTimeout := v.(int)
+		createOpts.MemberTimeout = &memberTimeout
 	}
 
 	/*if v, ok := d.GetOk("connection_limit"); ok {
@@ -201,7 +314,7 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
 	}
 
-	listener, err := listeners.Get(client, d.Id()).Extract()
+	listener, err := GetListenerV2(client, d.Id())
 	if err != nil {
 		return common.CheckDeleted(d, err, "listener")
 	}
@@ -223,8 +336,27 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("sni_container_refs", listener.SniContainerRefs),
 		d.Set("tls_ciphers_policy", listener.TlsCiphersPolicy),
 		d.Set("admin_state_up", listener.AdminStateUp),
+		d.Set("transparent_client_ip_enable", listener.TransparentClientIPEnable),
+		d.Set("keepalive_timeout", listener.KeepaliveTimeout),
+		d.Set("client_timeout", listener.ClientTimeout),
+		d.Set("member_timeout", listener.MemberTimeout),
 	)
 
+	// disabled headers are the same as no insert_headers block unless the block is set
+	headers := listener.InsertHeaders
+	forwardedELBIP := headers.ForwardedELBIP != nil && *headers.ForwardedELBIP
+	forwardedHost := headers.ForwardedHost != nil && *headers.ForwardedHost
+	var insertHeaders []map[string]interface{}
+	if forwardedELBIP || forwardedHost || len(d.Get("insert_headers").([]interface{})) > 0 {
+		insertHeaders = []map[string]interface{}{
+			{
+				"forwarded_elb_ip": forwardedELBIP,
+				"forwarded_host":   forwardedHost,
+			},
+		}
+	}
+	mErr = multierror.Append(mErr, d.Set("insert_headers", insertHeaders))
+
 	if mErr.ErrorOrNil() != nil {
 		return mErr
 	}
@@ -249,7 +381,7 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
 	}
 
-	var updateOpts listeners.UpdateOpts
+	var updateOpts ListenerV2UpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -286,6 +418,33 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tls_ciphers_policy") {
 		updateOpts.TlsCiphersPolicy = d.Get("tls_ciphers_policy").(string)
 	}
+	if d.HasChange("transparent_client_ip_enable") {
+		transparentClientIP := d.Get("transparent_client_ip_enable").(bool)
+		updateOpts.TransparentClientIPEnable = &transparentClientIP
+	}
+	if d.HasChange("keepalive_timeout") {
+		keepaliveTimeout := d.Get("keepalive_timeout").(int)
+		updateOpts.KeepaliveTimeout = &keepaliveTimeout
+	}
+	if d.HasChange("client_timeout") {
+		clientTimeout := d.Get("client_timeout").(int)
+		updateOpts.ClientTimeout = &clientTimeout
+	}
+	if d.HasChange("member_timeout") {
+		memberTimeout := d.Get("member_timeout").(int)
+		updateOpts.MemberTimeout = &memberTimeout
+	}
+	if d.HasChange("insert_headers") {
+		updateOpts.InsertHeaders = getListenerV2InsertHeaders(d)
+		// removed block disables the headers
+		if updateOpts.InsertHeaders == nil {
+			disabled := false
+			updateOpts.InsertHeaders = &ListenerV2InsertHeaders{
+				ForwardedELBIP: &disabled,
+				ForwardedHost:  &disabled,
+			}
+		}
+	}
 
 	// Wait for LoadBalancer to become active before continuing
 	lbID := d.Get("loadbalancer_id").(string)
@@ -296,7 +455,7 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), updateOpts)
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = listeners.Update(client, d.Id(), updateOpts).Extract()
+		_, err = UpdateListenerV2(client, d.Id(), updateOpts)
 		if err != nil {
 			return common.CheckForRetryableError(err)
 		}
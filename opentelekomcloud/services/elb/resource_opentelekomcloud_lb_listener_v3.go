@@ -0,0 +1,381 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceListenerV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceListenerV3Create,
+		Read:   resourceListenerV3Read,
+		Update: resourceListenerV3Update,
+		Delete: resourceListenerV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP", "HTTP", "HTTPS",
+				}, false),
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"default_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"default_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sni_container_refs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tls_ciphers_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tls-1-0", "tls-1-1", "tls-1-2", "tls-1-2-strict",
+					"tls-1-0-inherit", "tls-1-2-fs", "tls-1-0-with-1-3", "tls-1-2-fs-with-1-3",
+				}, false),
+			},
+			"http2_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ip_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "white",
+							ValidateFunc: validation.StringInSlice([]string{
+								"white", "black",
+							}, false),
+						},
+					},
+				},
+			},
+			"tags": common.TagsSchema(),
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getListenerV3IPGroup(d *schema.ResourceData) *ListenerV3IPGroup {
+	ipGroupRaw := d.Get("ip_group").([]interface{})
+	if len(ipGroupRaw) == 0 {
+		return nil
+	}
+	ipGroup := ipGroupRaw[0].(map[string]interface{})
+	enable := ipGroup["enable"].(bool)
+	return &ListenerV3IPGroup{
+		IPGroupID: ipGroup["id"].(string),
+		Enable:    &enable,
+		Type:      ipGroup["type"].(string),
+	}
+}
+
+func resourceListenerV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	http2Enable := d.Get("http2_enable").(bool)
+	createOpts := ListenerV3CreateOpts{
+		AdminStateUp:            &adminStateUp,
+		ClientCATLSContainerRef: d.Get("client_ca_tls_container_ref").(string),
+		DefaultPoolID:           d.Get("default_pool_id").(string),
+		DefaultTLSContainerRef:  d.Get("default_tls_container_ref").(string),
+		Description:             d.Get("description").(string),
+		HTTP2Enable:             &http2Enable,
+		LoadbalancerID:          d.Get("loadbalancer_id").(string),
+		Name:                    d.Get("name").(string),
+		Protocol:                d.Get("protocol").(string),
+		ProtocolPort:            d.Get("protocol_port").(int),
+		SniContainerRefs:        common.ExpandToStringSlice(d.Get("sni_container_refs").([]interface{})),
+		TLSCiphersPolicy:        d.Get("tls_ciphers_policy").(string),
+		IPGroup:                 getListenerV3IPGroup(d),
+		Tags:                    common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	lbID := createOpts.LoadbalancerID
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	var listener *ListenerV3
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		listener, err = CreateListenerV3(client, createOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating ListenerV3: %s", err)
+	}
+	d.SetId(listener.ID)
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourceListenerV3Read(d, meta)
+}
+
+func resourceListenerV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	listener, err := GetListenerV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "listenerV3")
+	}
+	log.Printf("[DEBUG] Retrieved listener %s: %#v", d.Id(), listener)
+
+	var ipGroup []map[string]interface{}
+	enable := listener.IPGroup.Enable != nil && *listener.IPGroup.Enable
+	// the disabled IP group stays bound to the listener after being removed from the configuration
+	if listener.IPGroup.IPGroupID != "" && (enable || len(d.Get("ip_group").([]interface{})) > 0) {
+		ipGroup = []map[string]interface{}{
+			{
+				"id":     listener.IPGroup.IPGroupID,
+				"enable": enable,
+				"type":   listener.IPGroup.Type,
+			},
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("protocol", listener.Protocol),
+		d.Set("protocol_port", listener.ProtocolPort),
+		d.Set("name", listener.Name),
+		d.Set("description", listener.Description),
+		d.Set("default_pool_id", listener.DefaultPoolID),
+		d.Set("default_tls_container_ref", listener.DefaultTLSContainerRef),
+		d.Set("client_ca_tls_container_ref", listener.ClientCATLSContainerRef),
+		d.Set("sni_container_refs", listener.SniContainerRefs),
+		d.Set("tls_ciphers_policy", listener.TLSCiphersPolicy),
+		d.Set("http2_enable", listener.HTTP2Enable),
+		d.Set("admin_state_up", listener.AdminStateUp),
+		d.Set("ip_group", ipGroup),
+		d.Set("tags", common.TagsToMap(listener.Tags)),
+		d.Set("created_at", listener.CreatedAt),
+		d.Set("updated_at", listener.UpdatedAt),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if len(listener.Loadbalancers) > 0 {
+		mErr = multierror.Append(mErr, d.Set("loadbalancer_id", listener.Loadbalancers[0].ID))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting ListenerV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceListenerV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	lbID := d.Get("loadbalancer_id").(string)
+	if d.HasChanges("name", "description", "default_pool_id", "default_tls_container_ref",
+		"client_ca_tls_container_ref", "sni_container_refs", "tls_ciphers_policy",
+		"http2_enable", "admin_state_up", "ip_group") {
+		var updateOpts ListenerV3UpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("default_pool_id") {
+			updateOpts.DefaultPoolID = d.Get("default_pool_id").(string)
+		}
+		if d.HasChange("default_tls_container_ref") {
+			updateOpts.DefaultTLSContainerRef = d.Get("default_tls_container_ref").(string)
+		}
+		if d.HasChange("client_ca_tls_container_ref") {
+			caRef := d.Get("client_ca_tls_container_ref").(string)
+			updateOpts.ClientCATLSContainerRef = &caRef
+		}
+		if d.HasChange("sni_container_refs") {
+			sniRefs := common.ExpandToStringSlice(d.Get("sni_container_refs").([]interface{}))
+			updateOpts.SniContainerRefs = &sniRefs
+		}
+		if d.HasChange("tls_ciphers_policy") {
+			updateOpts.TLSCiphersPolicy = d.Get("tls_ciphers_policy").(string)
+		}
+		if d.HasChange("http2_enable") {
+			http2Enable := d.Get("http2_enable").(bool)
+			updateOpts.HTTP2Enable = &http2Enable
+		}
+		if d.HasChange("admin_state_up") {
+			adminStateUp := d.Get("admin_state_up").(bool)
+			updateOpts.AdminStateUp = &adminStateUp
+		}
+		if d.HasChange("ip_group") {
+			updateOpts.IPGroup = getListenerV3IPGroup(d)
+			if updateOpts.IPGroup == nil {
+				// the access control is disabled when the IP group is removed
+				disable := false
+				updateOpts.IPGroup = &ListenerV3IPGroup{Enable: &disable}
+			}
+		}
+		log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), updateOpts)
+
+		if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+			return err
+		}
+
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			_, err := UpdateListenerV3(client, d.Id(), updateOpts)
+			if err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update ListenerV3 %s: %s", d.Id(), err)
+		}
+
+		if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+			return err
+		}
+	}
+
+	if err := common.UpdateResourceTags(lbV3TagsClient(client), d, "listeners", d.Id()); err != nil {
+		return fmt.Errorf("error updating tags of ListenerV3 %s: %s", d.Id(), err)
+	}
+
+	return resourceListenerV3Read(d, meta)
+}
+
+func resourceListenerV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	lbID := d.Get("loadbalancer_id").(string)
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting listener %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := DeleteListenerV3(client, d.Id()).ExtractErr()
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting ListenerV3")
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
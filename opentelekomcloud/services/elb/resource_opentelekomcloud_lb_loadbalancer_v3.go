@@ -0,0 +1,307 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceLoadBalancerV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerV3Create,
+		Read:   resourceLoadBalancerV3Read,
+		Update: resourceLoadBalancerV3Update,
+		Delete: resourceLoadBalancerV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"subnet_id", "ipv6_network_id", "network_ids"},
+			},
+			"vip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"subnet_id"},
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"ipv6_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ipv6_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"ipv6_network_id"},
+				ValidateFunc: validation.IsIPv6Address,
+			},
+			"network_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"l4_flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"l7_flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_target_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"tags": common.TagsSchema(),
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	ipTargetEnable := d.Get("ip_target_enable").(bool)
+	createOpts := LoadBalancerV3CreateOpts{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		VipAddress:           d.Get("vip_address").(string),
+		VipSubnetCidrID:      d.Get("subnet_id").(string),
+		IPv6VipVirsubnetID:   d.Get("ipv6_network_id").(string),
+		IPv6VipAddress:       d.Get("ipv6_address").(string),
+		L4FlavorID:           d.Get("l4_flavor").(string),
+		L7FlavorID:           d.Get("l7_flavor").(string),
+		AvailabilityZoneList: common.ExpandToStringSlice(d.Get("availability_zones").(*schema.Set).List()),
+		VpcID:                d.Get("router_id").(string),
+		ElbVirsubnetIDs:      common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List()),
+		IPTargetEnable:       &ipTargetEnable,
+		AdminStateUp:         &adminStateUp,
+		Tags:                 common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	lb, err := CreateLoadBalancerV3(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating LoadBalancerV3: %s", err)
+	}
+	d.SetId(lb.ID)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForLBV3LoadBalancer(client, lb.ID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourceLoadBalancerV3Read(d, meta)
+}
+
+func resourceLoadBalancerV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	lb, err := GetLoadBalancerV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "loadbalancerV3")
+	}
+	log.Printf("[DEBUG] Retrieved loadbalancer %s: %#v", d.Id(), lb)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", lb.Name),
+		d.Set("description", lb.Description),
+		d.Set("availability_zones", lb.AvailabilityZoneList),
+		d.Set("router_id", lb.VpcID),
+		d.Set("subnet_id", lb.VipSubnetCidrID),
+		d.Set("vip_address", lb.VipAddress),
+		d.Set("ipv6_network_id", lb.IPv6VipVirsubnetID),
+		d.Set("ipv6_address", lb.IPv6VipAddress),
+		d.Set("network_ids", lb.ElbVirsubnetIDs),
+		d.Set("l4_flavor", lb.L4FlavorID),
+		d.Set("l7_flavor", lb.L7FlavorID),
+		d.Set("ip_target_enable", lb.IPTargetEnable),
+		d.Set("admin_state_up", lb.AdminStateUp),
+		d.Set("tags", common.TagsToMap(lb.Tags)),
+		d.Set("vip_port_id", lb.VipPortID),
+		d.Set("ipv6_vip_port_id", lb.IPv6VipPortID),
+		d.Set("created_at", lb.CreatedAt),
+		d.Set("updated_at", lb.UpdatedAt),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting LoadBalancerV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLoadBalancerV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.HasChanges("name", "description", "network_ids", "l4_flavor", "l7_flavor", "ip_target_enable", "admin_state_up") {
+		var updateOpts LoadBalancerV3UpdateOpts
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("network_ids") {
+			updateOpts.ElbVirsubnetIDs = common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List())
+		}
+		if d.HasChange("l4_flavor") {
+			updateOpts.L4FlavorID = d.Get("l4_flavor").(string)
+		}
+		if d.HasChange("l7_flavor") {
+			updateOpts.L7FlavorID = d.Get("l7_flavor").(string)
+		}
+		if d.HasChange("ip_target_enable") {
+			ipTargetEnable := d.Get("ip_target_enable").(bool)
+			updateOpts.IPTargetEnable = &ipTargetEnable
+		}
+		if d.HasChange("admin_state_up") {
+			adminStateUp := d.Get("admin_state_up").(bool)
+			updateOpts.AdminStateUp = &adminStateUp
+		}
+		log.Printf("[DEBUG] Updating loadbalancer %s with options: %#v", d.Id(), updateOpts)
+
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			_, err := UpdateLoadBalancerV3(client, d.Id(), updateOpts)
+			if err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update LoadBalancerV3 %s: %s", d.Id(), err)
+		}
+
+		if err := waitForLBV3LoadBalancer(client, d.Id(), "ACTIVE", lbPendingStatuses, timeout); err != nil {
+			return err
+		}
+	}
+
+	if err := common.UpdateResourceTags(lbV3TagsClient(client), d, "loadbalancers", d.Id()); err != nil {
+		return fmt.Errorf("error updating tags of LoadBalancerV3 %s: %s", d.Id(), err)
+	}
+
+	return resourceLoadBalancerV3Read(d, meta)
+}
+
+func resourceLoadBalancerV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting loadbalancer %s", d.Id())
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := DeleteLoadBalancerV3(client, d.Id()).ExtractErr()
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting LoadBalancerV3")
+	}
+
+	if err := waitForLBV3LoadBalancer(client, d.Id(), "DELETED", lbV3PendingDeleteStatuses, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
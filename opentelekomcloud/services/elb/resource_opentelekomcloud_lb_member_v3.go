@@ -0,0 +1,274 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceMemberV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMemberV3Create,
+		Read:   resourceMemberV3Read,
+		Update: resourceMemberV3Update,
+		Delete: resourceMemberV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMemberV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMemberV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := MemberV3CreateOpts{
+		Address:      d.Get("address").(string),
+		ProtocolPort: d.Get("protocol_port").(int),
+		Name:         d.Get("name").(string),
+		SubnetID:     d.Get("subnet_id").(string),
+		AdminStateUp: &adminStateUp,
+	}
+	if v, ok := d.GetOk("weight"); ok {
+		weight := v.(int)
+		createOpts.Weight = &weight
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	poolID := d.Get("pool_id").(string)
+	lbID, err := getLBV3IDByPool(client, poolID)
+	if err != nil {
+		return err
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	var member *MemberV3
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		member, err = CreateMemberV3(client, poolID, createOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating MemberV3: %s", err)
+	}
+	d.SetId(member.ID)
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourceMemberV3Read(d, meta)
+}
+
+func resourceMemberV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	member, err := GetMemberV3(client, d.Get("pool_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "memberV3")
+	}
+	log.Printf("[DEBUG] Retrieved member %s: %#v", d.Id(), member)
+
+	mErr := multierror.Append(nil,
+		d.Set("address", member.Address),
+		d.Set("protocol_port", member.ProtocolPort),
+		d.Set("subnet_id", member.SubnetID),
+		d.Set("name", member.Name),
+		d.Set("weight", member.Weight),
+		d.Set("admin_state_up", member.AdminStateUp),
+		d.Set("operating_status", member.OperatingStatus),
+		d.Set("ip_version", member.IPVersion),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting MemberV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMemberV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	var updateOpts MemberV3UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("weight") {
+		weight := d.Get("weight").(int)
+		updateOpts.Weight = &weight
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	poolID := d.Get("pool_id").(string)
+	lbID, err := getLBV3IDByPool(client, poolID)
+	if err != nil {
+		return err
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating member %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err := UpdateMemberV3(client, poolID, d.Id(), updateOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update MemberV3 %s: %s", d.Id(), err)
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourceMemberV3Read(d, meta)
+}
+
+func resourceMemberV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	poolID := d.Get("pool_id").(string)
+	lbID, err := getLBV3IDByPool(client, poolID)
+	if err != nil {
+		return common.CheckDeleted(d, err, "error getting PoolV3")
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting member %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := DeleteMemberV3(client, poolID, d.Id()).ExtractErr()
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting MemberV3")
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceMemberV3Import imports the member using the `<pool_id>/<member_id>` ID format
+func resourceMemberV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for MemberV3, must be <pool_id>/<member_id>")
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("pool_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
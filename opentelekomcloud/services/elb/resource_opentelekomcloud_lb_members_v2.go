@@ -0,0 +1,225 @@
+package elb
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembersV2Create,
+		Read:   resourceMembersV2Read,
+		Update: resourceMembersV2Update,
+		Delete: resourceMembersV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      membersV2MemberHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// membersV2MemberHash ignores the computed member ID, so members are identified by the configuration only
+func membersV2MemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["protocol_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func getMembersV2BatchOpts(d *schema.ResourceData) BatchUpdateMembersV2Opts {
+	membersRaw := d.Get("member").(*schema.Set).List()
+	members := make([]BatchMemberV2Opts, len(membersRaw))
+	for i, v := range membersRaw {
+		member := v.(map[string]interface{})
+		weight := member["weight"].(int)
+		members[i] = BatchMemberV2Opts{
+			Address:      member["address"].(string),
+			ProtocolPort: member["protocol_port"].(int),
+			Weight:       &weight,
+			SubnetID:     member["subnet_id"].(string),
+		}
+	}
+	return BatchUpdateMembersV2Opts{Members: members}
+}
+
+// batchUpdateMembersV2 applies the whole member set to the pool, waiting for the load balancer
+// only once before and once after the change instead of once per member
+func batchUpdateMembersV2(client *golangsdk.ServiceClient, poolID string, opts BatchUpdateMembersV2Opts, timeout time.Duration) error {
+	if err := waitForLBV2viaPool(client, poolID, "ACTIVE", timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating members of pool %s with options: %#v", poolID, opts)
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		if err := BatchUpdateMembersV2(client, poolID, opts).ExtractErr(); err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating members of pool %s: %s", poolID, err)
+	}
+
+	return waitForLBV2viaPool(client, poolID, "ACTIVE", timeout)
+}
+
+func resourceMembersV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	if err := batchUpdateMembersV2(networkingClient, poolID, getMembersV2BatchOpts(d), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId(poolID)
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	allPages, err := pools.ListMembers(networkingClient, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return common.CheckDeleted(d, err, "members")
+	}
+	allMembers, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return fmt.Errorf("error extracting members of pool %s: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Retrieved members of pool %s: %#v", d.Id(), allMembers)
+
+	members := make([]interface{}, len(allMembers))
+	for i, member := range allMembers {
+		members[i] = map[string]interface{}{
+			"address":       member.Address,
+			"protocol_port": member.ProtocolPort,
+			"weight":        member.Weight,
+			"subnet_id":     member.SubnetID,
+			"id":            member.ID,
+		}
+	}
+
+	if err := d.Set("pool_id", d.Id()); err != nil {
+		return err
+	}
+	if err := d.Set("member", schema.NewSet(membersV2MemberHash, members)); err != nil {
+		return fmt.Errorf("error setting members: %s", err)
+	}
+	if err := d.Set("region", config.GetRegion(d)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceMembersV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if d.HasChange("member") {
+		if err := batchUpdateMembersV2(networkingClient, d.Id(), getMembersV2BatchOpts(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	opts := BatchUpdateMembersV2Opts{Members: []BatchMemberV2Opts{}}
+	if err := batchUpdateMembersV2(networkingClient, d.Id(), opts, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return common.CheckDeleted(d, err, "error deleting members")
+	}
+
+	d.SetId("")
+	return nil
+}
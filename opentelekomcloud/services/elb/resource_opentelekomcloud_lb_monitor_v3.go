@@ -0,0 +1,316 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceMonitorV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMonitorV3Create,
+		Read:   resourceMonitorV3Read,
+		Update: resourceMonitorV3Update,
+		Delete: resourceMonitorV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"HTTP", "HTTPS", "TCP", "UDP_CONNECT",
+				}, false),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"max_retries_down": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET", "HEAD", "POST", "PUT", "DELETE", "TRACE", "OPTIONS", "CONNECT", "PATCH",
+				}, false),
+			},
+			"expected_codes": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"monitor_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceMonitorV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := MonitorV3CreateOpts{
+		AdminStateUp:   &adminStateUp,
+		Delay:          d.Get("delay").(int),
+		DomainName:     d.Get("domain_name").(string),
+		ExpectedCodes:  d.Get("expected_codes").(string),
+		HTTPMethod:     d.Get("http_method").(string),
+		MaxRetries:     d.Get("max_retries").(int),
+		MaxRetriesDown: d.Get("max_retries_down").(int),
+		MonitorPort:    d.Get("monitor_port").(int),
+		Name:           d.Get("name").(string),
+		PoolID:         d.Get("pool_id").(string),
+		Timeout:        d.Get("timeout").(int),
+		Type:           d.Get("type").(string),
+		URLPath:        d.Get("url_path").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	lbID, err := getLBV3IDByPool(client, createOpts.PoolID)
+	if err != nil {
+		return err
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	var monitor *MonitorV3
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		monitor, err = CreateMonitorV3(client, createOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating MonitorV3: %s", err)
+	}
+	d.SetId(monitor.ID)
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourceMonitorV3Read(d, meta)
+}
+
+func resourceMonitorV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	monitor, err := GetMonitorV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "monitorV3")
+	}
+	log.Printf("[DEBUG] Retrieved monitor %s: %#v", d.Id(), monitor)
+
+	mErr := multierror.Append(nil,
+		d.Set("type", monitor.Type),
+		d.Set("delay", monitor.Delay),
+		d.Set("timeout", monitor.Timeout),
+		d.Set("max_retries", monitor.MaxRetries),
+		d.Set("max_retries_down", monitor.MaxRetriesDown),
+		d.Set("name", monitor.Name),
+		d.Set("domain_name", monitor.DomainName),
+		d.Set("url_path", monitor.URLPath),
+		d.Set("http_method", monitor.HTTPMethod),
+		d.Set("expected_codes", monitor.ExpectedCodes),
+		d.Set("monitor_port", monitor.MonitorPort),
+		d.Set("admin_state_up", monitor.AdminStateUp),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if len(monitor.Pools) > 0 {
+		mErr = multierror.Append(mErr, d.Set("pool_id", monitor.Pools[0].ID))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting MonitorV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMonitorV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	var updateOpts MonitorV3UpdateOpts
+	if d.HasChange("delay") {
+		updateOpts.Delay = d.Get("delay").(int)
+	}
+	if d.HasChange("timeout") {
+		updateOpts.Timeout = d.Get("timeout").(int)
+	}
+	if d.HasChange("max_retries") {
+		updateOpts.MaxRetries = d.Get("max_retries").(int)
+	}
+	if d.HasChange("max_retries_down") {
+		updateOpts.MaxRetriesDown = d.Get("max_retries_down").(int)
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("domain_name") {
+		domainName := d.Get("domain_name").(string)
+		updateOpts.DomainName = &domainName
+	}
+	if d.HasChange("url_path") {
+		updateOpts.URLPath = d.Get("url_path").(string)
+	}
+	if d.HasChange("http_method") {
+		updateOpts.HTTPMethod = d.Get("http_method").(string)
+	}
+	if d.HasChange("expected_codes") {
+		updateOpts.ExpectedCodes = d.Get("expected_codes").(string)
+	}
+	if d.HasChange("monitor_port") {
+		monitorPort := d.Get("monitor_port").(int)
+		updateOpts.MonitorPort = &monitorPort
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	lbID, err := getLBV3IDByPool(client, d.Get("pool_id").(string))
+	if err != nil {
+		return err
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating monitor %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err := UpdateMonitorV3(client, d.Id(), updateOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update MonitorV3 %s: %s", d.Id(), err)
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourceMonitorV3Read(d, meta)
+}
+
+func resourceMonitorV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	lbID, err := getLBV3IDByPool(client, d.Get("pool_id").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "error getting PoolV3")
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting monitor %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := DeleteMonitorV3(client, d.Id()).ExtractErr()
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting MonitorV3")
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,334 @@
+package elb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourcePoolV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePoolV3Create,
+		Read:   resourcePoolV3Read,
+		Update: resourcePoolV3Update,
+		Delete: resourcePoolV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"loadbalancer_id", "listener_id"},
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP", "HTTP", "HTTPS",
+				}, false),
+			},
+			"lb_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ROUND_ROBIN", "LEAST_CONNECTIONS", "SOURCE_IP",
+				}, false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"persistence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
+							}, false),
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ip_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getPoolV3Persistence(d *schema.ResourceData) (*SessionPersistenceV3, error) {
+	persistenceRaw := d.Get("persistence").([]interface{})
+	if len(persistenceRaw) == 0 {
+		return nil, nil
+	}
+	p := persistenceRaw[0].(map[string]interface{})
+	persistence := &SessionPersistenceV3{
+		Type:               p["type"].(string),
+		CookieName:         p["cookie_name"].(string),
+		PersistenceTimeout: p["timeout"].(int),
+	}
+	if persistence.Type == "APP_COOKIE" && persistence.CookieName == "" {
+		return nil, fmt.Errorf("persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
+	}
+	if persistence.Type != "APP_COOKIE" && persistence.CookieName != "" {
+		return nil, fmt.Errorf("persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
+	}
+	return persistence, nil
+}
+
+func resourcePoolV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	persistence, err := getPoolV3Persistence(d)
+	if err != nil {
+		return err
+	}
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := PoolV3CreateOpts{
+		AdminStateUp:       &adminStateUp,
+		Description:        d.Get("description").(string),
+		LBMethod:           d.Get("lb_method").(string),
+		ListenerID:         d.Get("listener_id").(string),
+		LoadbalancerID:     d.Get("loadbalancer_id").(string),
+		Name:               d.Get("name").(string),
+		Protocol:           d.Get("protocol").(string),
+		SessionPersistence: persistence,
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	lbID := createOpts.LoadbalancerID
+	if lbID == "" {
+		listener, err := GetListenerV3(client, createOpts.ListenerID)
+		if err != nil {
+			return fmt.Errorf("error getting ListenerV3 %s: %s", createOpts.ListenerID, err)
+		}
+		if len(listener.Loadbalancers) == 0 {
+			return fmt.Errorf("unable to determine loadbalancer ID of listener %s", listener.ID)
+		}
+		lbID = listener.Loadbalancers[0].ID
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	var pool *PoolV3
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		pool, err = CreatePoolV3(client, createOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating PoolV3: %s", err)
+	}
+	d.SetId(pool.ID)
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourcePoolV3Read(d, meta)
+}
+
+func resourcePoolV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	pool, err := GetPoolV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "poolV3")
+	}
+	log.Printf("[DEBUG] Retrieved pool %s: %#v", d.Id(), pool)
+
+	var persistence []map[string]interface{}
+	if pool.SessionPersistence != nil && pool.SessionPersistence.Type != "" {
+		persistence = []map[string]interface{}{
+			{
+				"type":        pool.SessionPersistence.Type,
+				"cookie_name": pool.SessionPersistence.CookieName,
+				"timeout":     pool.SessionPersistence.PersistenceTimeout,
+			},
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("protocol", pool.Protocol),
+		d.Set("lb_method", pool.LBMethod),
+		d.Set("name", pool.Name),
+		d.Set("description", pool.Description),
+		d.Set("admin_state_up", pool.AdminStateUp),
+		d.Set("persistence", persistence),
+		d.Set("ip_version", pool.IPVersion),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if len(pool.Loadbalancers) > 0 {
+		mErr = multierror.Append(mErr, d.Set("loadbalancer_id", pool.Loadbalancers[0].ID))
+	}
+	if len(pool.Listeners) > 0 {
+		mErr = multierror.Append(mErr, d.Set("listener_id", pool.Listeners[0].ID))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting PoolV3 fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePoolV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	var updateOpts PoolV3UpdateOpts
+	if d.HasChange("lb_method") {
+		updateOpts.LBMethod = d.Get("lb_method").(string)
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	lbID, err := getLBV3IDByPool(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err := UpdatePoolV3(client, d.Id(), updateOpts)
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update PoolV3 %s: %s", d.Id(), err)
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	return resourcePoolV3Read(d, meta)
+}
+
+func resourcePoolV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud ELBv3 client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	lbID, err := getLBV3IDByPool(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error getting PoolV3")
+	}
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting pool %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := DeletePoolV3(client, d.Id()).ExtractErr()
+		if err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting PoolV3")
+	}
+
+	if err := waitForLBV3LoadBalancer(client, lbID, "ACTIVE", lbPendingStatuses, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,950 @@
+package elb
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+)
+
+// ResourceRef represents the reference to the related dedicated load balancer resource.
+type ResourceRef struct {
+	ID string `json:"id"`
+}
+
+// LoadBalancerV3CreateOpts represents the attributes used when creating a new dedicated load balancer.
+type LoadBalancerV3CreateOpts struct {
+	Name                 string             `json:"name,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	VipAddress           string             `json:"vip_address,omitempty"`
+	VipSubnetCidrID      string             `json:"vip_subnet_cidr_id,omitempty"`
+	IPv6VipVirsubnetID   string             `json:"ipv6_vip_virsubnet_id,omitempty"`
+	IPv6VipAddress       string             `json:"ipv6_vip_address,omitempty"`
+	L4FlavorID           string             `json:"l4_flavor_id,omitempty"`
+	L7FlavorID           string             `json:"l7_flavor_id,omitempty"`
+	AvailabilityZoneList []string           `json:"availability_zone_list" required:"true"`
+	VpcID                string             `json:"vpc_id,omitempty"`
+	ElbVirsubnetIDs      []string           `json:"elb_virsubnet_ids,omitempty"`
+	IPTargetEnable       *bool              `json:"ip_target_enable,omitempty"`
+	AdminStateUp         *bool              `json:"admin_state_up,omitempty"`
+	Tags                 []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// ToLoadBalancerV3CreateMap builds a request body from LoadBalancerV3CreateOpts.
+func (opts LoadBalancerV3CreateOpts) ToLoadBalancerV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "loadbalancer")
+}
+
+// LoadBalancerV3UpdateOpts represents the attributes used when updating the dedicated load balancer.
+type LoadBalancerV3UpdateOpts struct {
+	Name            string   `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	L4FlavorID      string   `json:"l4_flavor_id,omitempty"`
+	L7FlavorID      string   `json:"l7_flavor_id,omitempty"`
+	ElbVirsubnetIDs []string `json:"elb_virsubnet_ids,omitempty"`
+	IPTargetEnable  *bool    `json:"ip_target_enable,omitempty"`
+	AdminStateUp    *bool    `json:"admin_state_up,omitempty"`
+}
+
+// ToLoadBalancerV3UpdateMap builds a request body from LoadBalancerV3UpdateOpts.
+func (opts LoadBalancerV3UpdateOpts) ToLoadBalancerV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "loadbalancer")
+}
+
+// LoadBalancerV3 represents the dedicated load balancer.
+type LoadBalancerV3 struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	Description          string             `json:"description"`
+	VipAddress           string             `json:"vip_address"`
+	VipPortID            string             `json:"vip_port_id"`
+	VipSubnetCidrID      string             `json:"vip_subnet_cidr_id"`
+	IPv6VipAddress       string             `json:"ipv6_vip_address"`
+	IPv6VipVirsubnetID   string             `json:"ipv6_vip_virsubnet_id"`
+	IPv6VipPortID        string             `json:"ipv6_vip_port_id"`
+	L4FlavorID           string             `json:"l4_flavor_id"`
+	L7FlavorID           string             `json:"l7_flavor_id"`
+	AvailabilityZoneList []string           `json:"availability_zone_list"`
+	VpcID                string             `json:"vpc_id"`
+	ElbVirsubnetIDs      []string           `json:"elb_virsubnet_ids"`
+	IPTargetEnable       bool               `json:"ip_target_enable"`
+	AdminStateUp         bool               `json:"admin_state_up"`
+	ProvisioningStatus   string             `json:"provisioning_status"`
+	OperatingStatus      string             `json:"operating_status"`
+	Tags                 []tags.ResourceTag `json:"tags"`
+	CreatedAt            string             `json:"created_at"`
+	UpdatedAt            string             `json:"updated_at"`
+}
+
+type loadBalancerV3Result struct {
+	golangsdk.Result
+}
+
+func (r loadBalancerV3Result) extract() (*LoadBalancerV3, error) {
+	var s struct {
+		LoadBalancer LoadBalancerV3 `json:"loadbalancer"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.LoadBalancer, err
+}
+
+// CreateLoadBalancerV3 creates a new dedicated load balancer.
+func CreateLoadBalancerV3(client *golangsdk.ServiceClient, opts LoadBalancerV3CreateOpts) (*LoadBalancerV3, error) {
+	b, err := opts.ToLoadBalancerV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r loadBalancerV3Result
+	_, r.Err = client.Post(client.ServiceURL("loadbalancers"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetLoadBalancerV3 retrieves the dedicated load balancer details.
+func GetLoadBalancerV3(client *golangsdk.ServiceClient, id string) (*LoadBalancerV3, error) {
+	var r loadBalancerV3Result
+	_, r.Err = client.Get(client.ServiceURL("loadbalancers", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateLoadBalancerV3 updates the dedicated load balancer.
+func UpdateLoadBalancerV3(client *golangsdk.ServiceClient, id string, opts LoadBalancerV3UpdateOpts) (*LoadBalancerV3, error) {
+	b, err := opts.ToLoadBalancerV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r loadBalancerV3Result
+	_, r.Err = client.Put(client.ServiceURL("loadbalancers", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteLoadBalancerV3 deletes the dedicated load balancer.
+func DeleteLoadBalancerV3(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("loadbalancers", id), nil)
+	return
+}
+
+// ListenerV3IPGroup represents the IP address group access control of the listener.
+type ListenerV3IPGroup struct {
+	IPGroupID string `json:"ipgroup_id,omitempty"`
+	Enable    *bool  `json:"enable_ipgroup,omitempty"`
+	Type      string `json:"type,omitempty"`
+}
+
+// ListenerV3CreateOpts represents the attributes used when creating a new dedicated load balancer listener.
+type ListenerV3CreateOpts struct {
+	AdminStateUp            *bool              `json:"admin_state_up,omitempty"`
+	ClientCATLSContainerRef string             `json:"client_ca_tls_container_ref,omitempty"`
+	DefaultPoolID           string             `json:"default_pool_id,omitempty"`
+	DefaultTLSContainerRef  string             `json:"default_tls_container_ref,omitempty"`
+	Description             string             `json:"description,omitempty"`
+	HTTP2Enable             *bool              `json:"http2_enable,omitempty"`
+	LoadbalancerID          string             `json:"loadbalancer_id" required:"true"`
+	Name                    string             `json:"name,omitempty"`
+	Protocol                string             `json:"protocol" required:"true"`
+	ProtocolPort            int                `json:"protocol_port" required:"true"`
+	SniContainerRefs        []string           `json:"sni_container_refs,omitempty"`
+	TLSCiphersPolicy        string             `json:"tls_ciphers_policy,omitempty"`
+	IPGroup                 *ListenerV3IPGroup `json:"ipgroup,omitempty"`
+	Tags                    []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// ToListenerV3CreateMap builds a request body from ListenerV3CreateOpts.
+func (opts ListenerV3CreateOpts) ToListenerV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "listener")
+}
+
+// ListenerV3UpdateOpts represents the attributes used when updating the dedicated load balancer listener.
+type ListenerV3UpdateOpts struct {
+	AdminStateUp            *bool              `json:"admin_state_up,omitempty"`
+	ClientCATLSContainerRef *string            `json:"client_ca_tls_container_ref,omitempty"`
+	DefaultPoolID           string             `json:"default_pool_id,omitempty"`
+	DefaultTLSContainerRef  string             `json:"default_tls_container_ref,omitempty"`
+	Description             *string            `json:"description,omitempty"`
+	HTTP2Enable             *bool              `json:"http2_enable,omitempty"`
+	Name                    *string            `json:"name,omitempty"`
+	SniContainerRefs        *[]string          `json:"sni_container_refs,omitempty"`
+	TLSCiphersPolicy        string             `json:"tls_ciphers_policy,omitempty"`
+	IPGroup                 *ListenerV3IPGroup `json:"ipgroup,omitempty"`
+}
+
+// ToListenerV3UpdateMap builds a request body from ListenerV3UpdateOpts.
+func (opts ListenerV3UpdateOpts) ToListenerV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "listener")
+}
+
+// ListenerV3 represents the dedicated load balancer listener.
+type ListenerV3 struct {
+	ID                      string             `json:"id"`
+	Name                    string             `json:"name"`
+	Description             string             `json:"description"`
+	AdminStateUp            bool               `json:"admin_state_up"`
+	ClientCATLSContainerRef string             `json:"client_ca_tls_container_ref"`
+	DefaultPoolID           string             `json:"default_pool_id"`
+	DefaultTLSContainerRef  string             `json:"default_tls_container_ref"`
+	HTTP2Enable             bool               `json:"http2_enable"`
+	Loadbalancers           []ResourceRef      `json:"loadbalancers"`
+	Protocol                string             `json:"protocol"`
+	ProtocolPort            int                `json:"protocol_port"`
+	SniContainerRefs        []string           `json:"sni_container_refs"`
+	TLSCiphersPolicy        string             `json:"tls_ciphers_policy"`
+	IPGroup                 ListenerV3IPGroup  `json:"ipgroup"`
+	Tags                    []tags.ResourceTag `json:"tags"`
+	CreatedAt               string             `json:"created_at"`
+	UpdatedAt               string             `json:"updated_at"`
+}
+
+type listenerV3Result struct {
+	golangsdk.Result
+}
+
+func (r listenerV3Result) extract() (*ListenerV3, error) {
+	var s struct {
+		Listener ListenerV3 `json:"listener"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Listener, err
+}
+
+// CreateListenerV3 creates a new dedicated load balancer listener.
+func CreateListenerV3(client *golangsdk.ServiceClient, opts ListenerV3CreateOpts) (*ListenerV3, error) {
+	b, err := opts.ToListenerV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r listenerV3Result
+	_, r.Err = client.Post(client.ServiceURL("listeners"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetListenerV3 retrieves the dedicated load balancer listener details.
+func GetListenerV3(client *golangsdk.ServiceClient, id string) (*ListenerV3, error) {
+	var r listenerV3Result
+	_, r.Err = client.Get(client.ServiceURL("listeners", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateListenerV3 updates the dedicated load balancer listener.
+func UpdateListenerV3(client *golangsdk.ServiceClient, id string, opts ListenerV3UpdateOpts) (*ListenerV3, error) {
+	b, err := opts.ToListenerV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r listenerV3Result
+	_, r.Err = client.Put(client.ServiceURL("listeners", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteListenerV3 deletes the dedicated load balancer listener.
+func DeleteListenerV3(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("listeners", id), nil)
+	return
+}
+
+// SessionPersistenceV3 represents the sticky session configuration of the pool.
+type SessionPersistenceV3 struct {
+	Type               string `json:"type" required:"true"`
+	CookieName         string `json:"cookie_name,omitempty"`
+	PersistenceTimeout int    `json:"persistence_timeout,omitempty"`
+}
+
+// PoolV3CreateOpts represents the attributes used when creating a new dedicated load balancer pool.
+type PoolV3CreateOpts struct {
+	AdminStateUp       *bool                 `json:"admin_state_up,omitempty"`
+	Description        string                `json:"description,omitempty"`
+	LBMethod           string                `json:"lb_algorithm" required:"true"`
+	ListenerID         string                `json:"listener_id,omitempty"`
+	LoadbalancerID     string                `json:"loadbalancer_id,omitempty"`
+	Name               string                `json:"name,omitempty"`
+	Protocol           string                `json:"protocol" required:"true"`
+	SessionPersistence *SessionPersistenceV3 `json:"session_persistence,omitempty"`
+}
+
+// ToPoolV3CreateMap builds a request body from PoolV3CreateOpts.
+func (opts PoolV3CreateOpts) ToPoolV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "pool")
+}
+
+// PoolV3UpdateOpts represents the attributes used when updating the dedicated load balancer pool.
+type PoolV3UpdateOpts struct {
+	AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	LBMethod     string  `json:"lb_algorithm,omitempty"`
+	Name         *string `json:"name,omitempty"`
+}
+
+// ToPoolV3UpdateMap builds a request body from PoolV3UpdateOpts.
+func (opts PoolV3UpdateOpts) ToPoolV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "pool")
+}
+
+// PoolV3 represents the dedicated load balancer pool.
+type PoolV3 struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Description        string                `json:"description"`
+	AdminStateUp       bool                  `json:"admin_state_up"`
+	LBMethod           string                `json:"lb_algorithm"`
+	Protocol           string                `json:"protocol"`
+	Listeners          []ResourceRef         `json:"listeners"`
+	Loadbalancers      []ResourceRef         `json:"loadbalancers"`
+	Members            []ResourceRef         `json:"members"`
+	HealthMonitorID    string                `json:"healthmonitor_id"`
+	SessionPersistence *SessionPersistenceV3 `json:"session_persistence"`
+	IPVersion          string                `json:"ip_version"`
+}
+
+type poolV3Result struct {
+	golangsdk.Result
+}
+
+func (r poolV3Result) extract() (*PoolV3, error) {
+	var s struct {
+		Pool PoolV3 `json:"pool"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Pool, err
+}
+
+// CreatePoolV3 creates a new dedicated load balancer pool.
+func CreatePoolV3(client *golangsdk.ServiceClient, opts PoolV3CreateOpts) (*PoolV3, error) {
+	b, err := opts.ToPoolV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r poolV3Result
+	_, r.Err = client.Post(client.ServiceURL("pools"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetPoolV3 retrieves the dedicated load balancer pool details.
+func GetPoolV3(client *golangsdk.ServiceClient, id string) (*PoolV3, error) {
+	var r poolV3Result
+	_, r.Err = client.Get(client.ServiceURL("pools", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdatePoolV3 updates the dedicated load balancer pool.
+func UpdatePoolV3(client *golangsdk.ServiceClient, id string, opts PoolV3UpdateOpts) (*PoolV3, error) {
+	b, err := opts.ToPoolV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r poolV3Result
+	_, r.Err = client.Put(client.ServiceURL("pools", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeletePoolV3 deletes the dedicated load balancer pool.
+func DeletePoolV3(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("pools", id), nil)
+	return
+}
+
+// MemberV3CreateOpts represents the attributes used when creating a new dedicated load balancer pool member.
+type MemberV3CreateOpts struct {
+	Address      string `json:"address" required:"true"`
+	ProtocolPort int    `json:"protocol_port" required:"true"`
+	Name         string `json:"name,omitempty"`
+	SubnetID     string `json:"subnet_cidr_id,omitempty"`
+	Weight       *int   `json:"weight,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+// ToMemberV3CreateMap builds a request body from MemberV3CreateOpts.
+func (opts MemberV3CreateOpts) ToMemberV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "member")
+}
+
+// MemberV3UpdateOpts represents the attributes used when updating the dedicated load balancer pool member.
+type MemberV3UpdateOpts struct {
+	Name         *string `json:"name,omitempty"`
+	Weight       *int    `json:"weight,omitempty"`
+	AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+}
+
+// ToMemberV3UpdateMap builds a request body from MemberV3UpdateOpts.
+func (opts MemberV3UpdateOpts) ToMemberV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "member")
+}
+
+// MemberV3 represents the dedicated load balancer pool member.
+type MemberV3 struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Address         string `json:"address"`
+	ProtocolPort    int    `json:"protocol_port"`
+	SubnetID        string `json:"subnet_cidr_id"`
+	Weight          int    `json:"weight"`
+	AdminStateUp    bool   `json:"admin_state_up"`
+	OperatingStatus string `json:"operating_status"`
+	IPVersion       string `json:"ip_version"`
+}
+
+type memberV3Result struct {
+	golangsdk.Result
+}
+
+func (r memberV3Result) extract() (*MemberV3, error) {
+	var s struct {
+		Member MemberV3 `json:"member"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Member, err
+}
+
+// CreateMemberV3 adds a new member to the dedicated load balancer pool.
+func CreateMemberV3(client *golangsdk.ServiceClient, poolID string, opts MemberV3CreateOpts) (*MemberV3, error) {
+	b, err := opts.ToMemberV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r memberV3Result
+	_, r.Err = client.Post(client.ServiceURL("pools", poolID, "members"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetMemberV3 retrieves the dedicated load balancer pool member details.
+func GetMemberV3(client *golangsdk.ServiceClient, poolID, id string) (*MemberV3, error) {
+	var r memberV3Result
+	_, r.Err = client.Get(client.ServiceURL("pools", poolID, "members", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateMemberV3 updates the dedicated load balancer pool member.
+func UpdateMemberV3(client *golangsdk.ServiceClient, poolID, id string, opts MemberV3UpdateOpts) (*MemberV3, error) {
+	b, err := opts.ToMemberV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r memberV3Result
+	_, r.Err = client.Put(client.ServiceURL("pools", poolID, "members", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteMemberV3 removes the member from the dedicated load balancer pool.
+func DeleteMemberV3(client *golangsdk.ServiceClient, poolID, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("pools", poolID, "members", id), nil)
+	return
+}
+
+// MonitorV3CreateOpts represents the attributes used when creating a new dedicated load balancer health monitor.
+type MonitorV3CreateOpts struct {
+	AdminStateUp   *bool  `json:"admin_state_up,omitempty"`
+	Delay          int    `json:"delay" required:"true"`
+	DomainName     string `json:"domain_name,omitempty"`
+	ExpectedCodes  string `json:"expected_codes,omitempty"`
+	HTTPMethod     string `json:"http_method,omitempty"`
+	MaxRetries     int    `json:"max_retries" required:"true"`
+	MaxRetriesDown int    `json:"max_retries_down,omitempty"`
+	MonitorPort    int    `json:"monitor_port,omitempty"`
+	Name           string `json:"name,omitempty"`
+	PoolID         string `json:"pool_id" required:"true"`
+	Timeout        int    `json:"timeout" required:"true"`
+	Type           string `json:"type" required:"true"`
+	URLPath        string `json:"url_path,omitempty"`
+}
+
+// ToMonitorV3CreateMap builds a request body from MonitorV3CreateOpts.
+func (opts MonitorV3CreateOpts) ToMonitorV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "healthmonitor")
+}
+
+// MonitorV3UpdateOpts represents the attributes used when updating the dedicated load balancer health monitor.
+type MonitorV3UpdateOpts struct {
+	AdminStateUp   *bool   `json:"admin_state_up,omitempty"`
+	Delay          int     `json:"delay,omitempty"`
+	DomainName     *string `json:"domain_name,omitempty"`
+	ExpectedCodes  string  `json:"expected_codes,omitempty"`
+	HTTPMethod     string  `json:"http_method,omitempty"`
+	MaxRetries     int     `json:"max_retries,omitempty"`
+	MaxRetriesDown int     `json:"max_retries_down,omitempty"`
+	MonitorPort    *int    `json:"monitor_port,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	Timeout        int     `json:"timeout,omitempty"`
+	URLPath        string  `json:"url_path,omitempty"`
+}
+
+// ToMonitorV3UpdateMap builds a request body from MonitorV3UpdateOpts.
+func (opts MonitorV3UpdateOpts) ToMonitorV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "healthmonitor")
+}
+
+// MonitorV3 represents the dedicated load balancer health monitor.
+type MonitorV3 struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	AdminStateUp   bool          `json:"admin_state_up"`
+	Delay          int           `json:"delay"`
+	DomainName     string        `json:"domain_name"`
+	ExpectedCodes  string        `json:"expected_codes"`
+	HTTPMethod     string        `json:"http_method"`
+	MaxRetries     int           `json:"max_retries"`
+	MaxRetriesDown int           `json:"max_retries_down"`
+	MonitorPort    int           `json:"monitor_port"`
+	Pools          []ResourceRef `json:"pools"`
+	Timeout        int           `json:"timeout"`
+	Type           string        `json:"type"`
+	URLPath        string        `json:"url_path"`
+}
+
+type monitorV3Result struct {
+	golangsdk.Result
+}
+
+func (r monitorV3Result) extract() (*MonitorV3, error) {
+	var s struct {
+		Monitor MonitorV3 `json:"healthmonitor"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Monitor, err
+}
+
+// CreateMonitorV3 creates a new dedicated load balancer health monitor.
+func CreateMonitorV3(client *golangsdk.ServiceClient, opts MonitorV3CreateOpts) (*MonitorV3, error) {
+	b, err := opts.ToMonitorV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r monitorV3Result
+	_, r.Err = client.Post(client.ServiceURL("healthmonitors"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetMonitorV3 retrieves the dedicated load balancer health monitor details.
+func GetMonitorV3(client *golangsdk.ServiceClient, id string) (*MonitorV3, error) {
+	var r monitorV3Result
+	_, r.Err = client.Get(client.ServiceURL("healthmonitors", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateMonitorV3 updates the dedicated load balancer health monitor.
+func UpdateMonitorV3(client *golangsdk.ServiceClient, id string, opts MonitorV3UpdateOpts) (*MonitorV3, error) {
+	b, err := opts.ToMonitorV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r monitorV3Result
+	_, r.Err = client.Put(client.ServiceURL("healthmonitors", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteMonitorV3 deletes the dedicated load balancer health monitor.
+func DeleteMonitorV3(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("healthmonitors", id), nil)
+	return
+}
+
+// CertificateV3CreateOpts represents the attributes used when creating a new dedicated load balancer certificate.
+type CertificateV3CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	PrivateKey  string `json:"private_key,omitempty"`
+	Certificate string `json:"certificate" required:"true"`
+}
+
+// ToCertificateV3CreateMap builds a request body from CertificateV3CreateOpts.
+func (opts CertificateV3CreateOpts) ToCertificateV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "certificate")
+}
+
+// CertificateV3UpdateOpts represents the attributes used when updating the dedicated load balancer certificate.
+type CertificateV3UpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Domain      string  `json:"domain,omitempty"`
+	PrivateKey  string  `json:"private_key,omitempty"`
+	Certificate string  `json:"certificate,omitempty"`
+}
+
+// ToCertificateV3UpdateMap builds a request body from CertificateV3UpdateOpts.
+func (opts CertificateV3UpdateOpts) ToCertificateV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "certificate")
+}
+
+// CertificateV3 represents the dedicated load balancer certificate.
+type CertificateV3 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Domain      string `json:"domain"`
+	PrivateKey  string `json:"private_key"`
+	Certificate string `json:"certificate"`
+	ExpireTime  string `json:"expire_time"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type certificateV3Result struct {
+	golangsdk.Result
+}
+
+func (r certificateV3Result) extract() (*CertificateV3, error) {
+	var s struct {
+		Certificate CertificateV3 `json:"certificate"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Certificate, err
+}
+
+// CreateCertificateV3 creates a new dedicated load balancer certificate.
+func CreateCertificateV3(client *golangsdk.ServiceClient, opts CertificateV3CreateOpts) (*CertificateV3, error) {
+	b, err := opts.ToCertificateV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r certificateV3Result
+	_, r.Err = client.Post(client.ServiceURL("certificates"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetCertificateV3 retrieves the dedicated load balancer certificate details.
+func GetCertificateV3(client *golangsdk.ServiceClient, id string) (*CertificateV3, error) {
+	var r certificateV3Result
+	_, r.Err = client.Get(client.ServiceURL("certificates", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateCertificateV3 updates the dedicated load balancer certificate.
+func UpdateCertificateV3(client *golangsdk.ServiceClient, id string, opts CertificateV3UpdateOpts) (*CertificateV3, error) {
+	b, err := opts.ToCertificateV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r certificateV3Result
+	_, r.Err = client.Put(client.ServiceURL("certificates", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteCertificateV3 deletes the dedicated load balancer certificate.
+func DeleteCertificateV3(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("certificates", id), nil)
+	return
+}
+
+// IPGroupV3IP represents the single IP address or CIDR block of the IP address group.
+type IPGroupV3IP struct {
+	IP          string `json:"ip" required:"true"`
+	Description string `json:"description,omitempty"`
+}
+
+// IPGroupV3CreateOpts represents the attributes used when creating a new IP address group.
+type IPGroupV3CreateOpts struct {
+	Name        string        `json:"name,omitempty"`
+	Description string        `json:"description,omitempty"`
+	IPList      []IPGroupV3IP `json:"ip_list" required:"true"`
+}
+
+// ToIPGroupV3CreateMap builds a request body from IPGroupV3CreateOpts.
+func (opts IPGroupV3CreateOpts) ToIPGroupV3CreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "ipgroup")
+}
+
+// IPGroupV3UpdateOpts represents the attributes used when updating the IP address group.
+type IPGroupV3UpdateOpts struct {
+	Name        *string        `json:"name,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	IPList      *[]IPGroupV3IP `json:"ip_list,omitempty"`
+}
+
+// ToIPGroupV3UpdateMap builds a request body from IPGroupV3UpdateOpts.
+func (opts IPGroupV3UpdateOpts) ToIPGroupV3UpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "ipgroup")
+}
+
+// IPGroupV3 represents the IP address group used for the listener access control.
+type IPGroupV3 struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	IPList      []IPGroupV3IP `json:"ip_list"`
+	Listeners   []ResourceRef `json:"listeners"`
+	CreatedAt   string        `json:"created_at"`
+	UpdatedAt   string        `json:"updated_at"`
+}
+
+type ipGroupV3Result struct {
+	golangsdk.Result
+}
+
+func (r ipGroupV3Result) extract() (*IPGroupV3, error) {
+	var s struct {
+		IPGroup IPGroupV3 `json:"ipgroup"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.IPGroup, err
+}
+
+// CreateIPGroupV3 creates a new IP address group.
+func CreateIPGroupV3(client *golangsdk.ServiceClient, opts IPGroupV3CreateOpts) (*IPGroupV3, error) {
+	b, err := opts.ToIPGroupV3CreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r ipGroupV3Result
+	_, r.Err = client.Post(client.ServiceURL("ipgroups"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return r.extract()
+}
+
+// GetIPGroupV3 retrieves the IP address group details.
+func GetIPGroupV3(client *golangsdk.ServiceClient, id string) (*IPGroupV3, error) {
+	var r ipGroupV3Result
+	_, r.Err = client.Get(client.ServiceURL("ipgroups", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateIPGroupV3 updates the IP address group.
+func UpdateIPGroupV3(client *golangsdk.ServiceClient, id string, opts IPGroupV3UpdateOpts) (*IPGroupV3, error) {
+	b, err := opts.ToIPGroupV3UpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r ipGroupV3Result
+	_, r.Err = client.Put(client.ServiceURL("ipgroups", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteIPGroupV3 deletes the IP address group.
+func DeleteIPGroupV3(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("ipgroups", id), nil)
+	return
+}
+
+// BatchMemberV2Opts represents the single member of the shared load balancer pool
+// used in the batch update request.
+type BatchMemberV2Opts struct {
+	Address      string `json:"address" required:"true"`
+	ProtocolPort int    `json:"protocol_port" required:"true"`
+	Weight       *int   `json:"weight,omitempty"`
+	SubnetID     string `json:"subnet_id,omitempty"`
+}
+
+// BatchUpdateMembersV2Opts represents the full set of the shared load balancer pool members.
+type BatchUpdateMembersV2Opts struct {
+	Members []BatchMemberV2Opts `json:"members"`
+}
+
+// ToBatchUpdateMembersV2Map builds a request body from BatchUpdateMembersV2Opts.
+func (opts BatchUpdateMembersV2Opts) ToBatchUpdateMembersV2Map() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// BatchUpdateMembersV2 replaces all members of the shared load balancer pool in a single request.
+// Members missing in the request are removed, existing members are matched by address and port.
+func BatchUpdateMembersV2(client *golangsdk.ServiceClient, poolID string, opts BatchUpdateMembersV2Opts) (r golangsdk.ErrResult) {
+	b, err := opts.ToBatchUpdateMembersV2Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "pools", poolID, "members"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// ListenerV2InsertHeaders represents the HTTP headers added by the shared load balancer listener.
+type ListenerV2InsertHeaders struct {
+	ForwardedELBIP *bool `json:"X-Forwarded-ELB-IP,omitempty"`
+	ForwardedHost  *bool `json:"X-Forwarded-Host,omitempty"`
+}
+
+// ListenerV2CreateOpts extends listeners.CreateOpts with the OTC specific listener options.
+type ListenerV2CreateOpts struct {
+	listeners.CreateOpts
+	TransparentClientIPEnable *bool                    `json:"transparent_client_ip_enable,omitempty"`
+	KeepaliveTimeout          *int                     `json:"keepalive_timeout,omitempty"`
+	ClientTimeout             *int                     `json:"client_timeout,omitempty"`
+	MemberTimeout             *int                     `json:"member_timeout,omitempty"`
+	InsertHeaders             *ListenerV2InsertHeaders `json:"insert_headers,omitempty"`
+}
+
+// ToListenerCreateMap builds a request body from ListenerV2CreateOpts.
+func (opts ListenerV2CreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "listener")
+}
+
+// ListenerV2UpdateOpts extends listeners.UpdateOpts with the OTC specific listener options.
+type ListenerV2UpdateOpts struct {
+	listeners.UpdateOpts
+	TransparentClientIPEnable *bool                    `json:"transparent_client_ip_enable,omitempty"`
+	KeepaliveTimeout          *int                     `json:"keepalive_timeout,omitempty"`
+	ClientTimeout             *int                     `json:"client_timeout,omitempty"`
+	MemberTimeout             *int                     `json:"member_timeout,omitempty"`
+	InsertHeaders             *ListenerV2InsertHeaders `json:"insert_headers,omitempty"`
+}
+
+// ToListenerUpdateMap builds a request body from ListenerV2UpdateOpts.
+func (opts ListenerV2UpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "listener")
+}
+
+// ListenerV2 represents the shared load balancer listener including the OTC specific fields.
+type ListenerV2 struct {
+	listeners.Listener
+	TransparentClientIPEnable bool                    `json:"transparent_client_ip_enable"`
+	KeepaliveTimeout          int                     `json:"keepalive_timeout"`
+	ClientTimeout             int                     `json:"client_timeout"`
+	MemberTimeout             int                     `json:"member_timeout"`
+	InsertHeaders             ListenerV2InsertHeaders `json:"insert_headers"`
+}
+
+type listenerV2Result struct {
+	golangsdk.Result
+}
+
+func (r listenerV2Result) extract() (*ListenerV2, error) {
+	var s struct {
+		Listener ListenerV2 `json:"listener"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Listener, err
+}
+
+// GetListenerV2 retrieves the shared load balancer listener details.
+func GetListenerV2(client *golangsdk.ServiceClient, id string) (*ListenerV2, error) {
+	var r listenerV2Result
+	_, r.Err = client.Get(client.ServiceURL("lbaas", "listeners", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateListenerV2 updates the shared load balancer listener.
+// listeners.Update accepts only listeners.UpdateOpts, so OTC specific options can't be passed there.
+func UpdateListenerV2(client *golangsdk.ServiceClient, id string, opts ListenerV2UpdateOpts) (*ListenerV2, error) {
+	b, err := opts.ToListenerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r listenerV2Result
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "listeners", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r.extract()
+}
+
+// L7PolicyV2RedirectURLConfig represents the URL the request is redirected to with REDIRECT_TO_URL action.
+// Empty parts are kept the same as in the original request.
+type L7PolicyV2RedirectURLConfig struct {
+	Protocol   string `json:"protocol,omitempty"`
+	Host       string `json:"host,omitempty"`
+	Port       string `json:"port,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Query      string `json:"query,omitempty"`
+	StatusCode string `json:"status_code" required:"true"`
+}
+
+// L7PolicyV2FixedResponseConfig represents the response returned with FIXED_RESPONSE action.
+type L7PolicyV2FixedResponseConfig struct {
+	StatusCode  string `json:"status_code" required:"true"`
+	ContentType string `json:"content_type,omitempty"`
+	MessageBody string `json:"message_body,omitempty"`
+}
+
+// L7PolicyV2CreateOpts extends l7policies.CreateOpts with REDIRECT_TO_URL and FIXED_RESPONSE action options.
+type L7PolicyV2CreateOpts struct {
+	l7policies.CreateOpts
+	RedirectURLConfig   *L7PolicyV2RedirectURLConfig   `json:"redirect_url_config,omitempty"`
+	FixedResponseConfig *L7PolicyV2FixedResponseConfig `json:"fixed_response_config,omitempty"`
+}
+
+// ToL7PolicyCreateMap builds a request body from L7PolicyV2CreateOpts.
+func (opts L7PolicyV2CreateOpts) ToL7PolicyCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "l7policy")
+}
+
+// L7PolicyV2UpdateOpts extends l7policies.UpdateOpts with REDIRECT_TO_URL and FIXED_RESPONSE action options.
+type L7PolicyV2UpdateOpts struct {
+	l7policies.UpdateOpts
+	RedirectURLConfig   *L7PolicyV2RedirectURLConfig   `json:"redirect_url_config,omitempty"`
+	FixedResponseConfig *L7PolicyV2FixedResponseConfig `json:"fixed_response_config,omitempty"`
+}
+
+// ToL7PolicyUpdateMap builds a request body from L7PolicyV2UpdateOpts.
+func (opts L7PolicyV2UpdateOpts) ToL7PolicyUpdateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "l7policy")
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["l7policy"].(map[string]interface{})
+	if m["redirect_pool_id"] == "" {
+		m["redirect_pool_id"] = nil
+	}
+	if m["redirect_listener_id"] == "" {
+		m["redirect_listener_id"] = nil
+	}
+
+	return b, nil
+}
+
+// L7PolicyV2 represents the shared load balancer L7 policy including the OTC specific action options.
+type L7PolicyV2 struct {
+	l7policies.L7Policy
+	RedirectURLConfig   *L7PolicyV2RedirectURLConfig   `json:"redirect_url_config"`
+	FixedResponseConfig *L7PolicyV2FixedResponseConfig `json:"fixed_response_config"`
+}
+
+type l7PolicyV2Result struct {
+	golangsdk.Result
+}
+
+func (r l7PolicyV2Result) extract() (*L7PolicyV2, error) {
+	var s struct {
+		L7Policy L7PolicyV2 `json:"l7policy"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.L7Policy, err
+}
+
+// GetL7PolicyV2 retrieves the shared load balancer L7 policy details.
+func GetL7PolicyV2(client *golangsdk.ServiceClient, id string) (*L7PolicyV2, error) {
+	var r l7PolicyV2Result
+	_, r.Err = client.Get(client.ServiceURL("lbaas", "l7policies", id), &r.Body, nil)
+	return r.extract()
+}
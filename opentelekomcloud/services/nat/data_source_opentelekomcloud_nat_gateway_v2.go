@@ -0,0 +1,126 @@
+package nat
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/natgateways"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceNatGatewayV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNatGatewayV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"internal_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNatGatewayV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NAT client: %s", err)
+	}
+
+	listOpts := natgateways.ListOpts{
+		ID:                d.Get("id").(string),
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Spec:              d.Get("spec").(string),
+		RouterID:          d.Get("router_id").(string),
+		InternalNetworkID: d.Get("internal_network_id").(string),
+		Status:            d.Get("status").(string),
+	}
+
+	pages, err := natgateways.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to list NAT gateways: %s", err)
+	}
+	gateways, err := natgateways.ExtractNatGateways(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract NAT gateways: %s", err)
+	}
+
+	if len(gateways) < 1 {
+		return fmt.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+	if len(gateways) > 1 {
+		return fmt.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	gateway := gateways[0]
+	log.Printf("[DEBUG] Retrieved NAT gateway %s: %+v", gateway.ID, gateway)
+	d.SetId(gateway.ID)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", gateway.Name),
+		d.Set("description", gateway.Description),
+		d.Set("spec", gateway.Spec),
+		d.Set("router_id", gateway.RouterID),
+		d.Set("internal_network_id", gateway.InternalNetworkID),
+		d.Set("status", gateway.Status),
+		d.Set("tenant_id", gateway.TenantID),
+		d.Set("admin_state_up", gateway.AdminStateUp),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting NAT gateway fields: %s", err)
+	}
+
+	return nil
+}
@@ -18,8 +18,11 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -36,6 +39,8 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateDnatPortRanges,
+
 		Schema: map[string]*schema.Schema{
 			"floating_ip_id": {
 				Type:     schema.TypeString,
@@ -44,9 +49,21 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 
 			"internal_service_port": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"internal_service_port", "internal_service_port_range"},
+			},
+
+			"internal_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePortRange,
+				ExactlyOneOf: []string{"internal_service_port", "internal_service_port_range"},
+				RequiredWith: []string{"external_service_port_range"},
 			},
 
 			"nat_gateway_id": {
@@ -76,9 +93,28 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 
 			"external_service_port": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"external_service_port", "external_service_port_range"},
+			},
+
+			"external_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePortRange,
+				ExactlyOneOf: []string{"external_service_port", "external_service_port_range"},
+				RequiredWith: []string{"internal_service_port_range"},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
 
 			"created_at": {
@@ -104,15 +140,63 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 	}
 }
 
+var portRangeRegexp = regexp.MustCompile(`^(\d{1,5})-(\d{1,5})$`)
+
+// parsePortRange parses the port range in the `<start>-<end>` format, e.g. `1-100`
+func parsePortRange(value string) (int, int, error) {
+	match := portRangeRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return 0, 0, fmt.Errorf("must be a port range in the `<start>-<end>` format, got %q", value)
+	}
+	start, _ := strconv.Atoi(match[1])
+	end, _ := strconv.Atoi(match[2])
+	if start < 1 || end > 65535 || start > end {
+		return 0, 0, fmt.Errorf("must be a port range with 1 <= start <= end <= 65535, got %q", value)
+	}
+	return start, end, nil
+}
+
+func validatePortRange(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parsePortRange(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s %s", k, err))
+	}
+	return
+}
+
+// validateDnatPortRanges checks that the internal and the external port ranges have the same length
+func validateDnatPortRanges(d *schema.ResourceDiff, _ interface{}) error {
+	internalRange := d.Get("internal_service_port_range").(string)
+	externalRange := d.Get("external_service_port_range").(string)
+	if internalRange == "" || externalRange == "" {
+		return nil
+	}
+	internalStart, internalEnd, err := parsePortRange(internalRange)
+	if err != nil {
+		return nil
+	}
+	externalStart, externalEnd, err := parsePortRange(externalRange)
+	if err != nil {
+		return nil
+	}
+	if internalEnd-internalStart != externalEnd-externalStart {
+		return fmt.Errorf("internal_service_port_range %q and external_service_port_range %q must have the same length",
+			internalRange, externalRange)
+	}
+	return nil
+}
+
 func resourceNatDnatUserInputParams(d *schema.ResourceData) map[string]interface{} {
 	return map[string]interface{}{
-		"external_service_port": d.Get("external_service_port"),
-		"floating_ip_id":        d.Get("floating_ip_id"),
-		"internal_service_port": d.Get("internal_service_port"),
-		"nat_gateway_id":        d.Get("nat_gateway_id"),
-		"port_id":               d.Get("port_id"),
-		"private_ip":            d.Get("private_ip"),
-		"protocol":              d.Get("protocol"),
+		"description":                 d.Get("description"),
+		"external_service_port":       d.Get("external_service_port"),
+		"external_service_port_range": d.Get("external_service_port_range"),
+		"floating_ip_id":              d.Get("floating_ip_id"),
+		"internal_service_port":       d.Get("internal_service_port"),
+		"internal_service_port_range": d.Get("internal_service_port_range"),
+		"nat_gateway_id":              d.Get("nat_gateway_id"),
+		"port_id":                     d.Get("port_id"),
+		"private_ip":                  d.Get("private_ip"),
+		"protocol":                    d.Get("protocol"),
 	}
 }
 
@@ -163,6 +247,42 @@ func resourceNatDnatRuleCreate(d *schema.ResourceData, meta interface{}) error {
 		params["external_service_port"] = externalServicePortProp
 	}
 
+	internalServicePortRangeProp, err := common.NavigateValue(opts, []string{"internal_service_port_range"}, nil)
+	if err != nil {
+		return err
+	}
+	e, err = common.IsEmptyValue(reflect.ValueOf(internalServicePortRangeProp))
+	if err != nil {
+		return err
+	}
+	if !e {
+		params["internal_service_port_range"] = internalServicePortRangeProp
+	}
+
+	externalServicePortRangeProp, err := common.NavigateValue(opts, []string{"external_service_port_range"}, nil)
+	if err != nil {
+		return err
+	}
+	e, err = common.IsEmptyValue(reflect.ValueOf(externalServicePortRangeProp))
+	if err != nil {
+		return err
+	}
+	if !e {
+		params["external_service_port_range"] = externalServicePortRangeProp
+	}
+
+	descriptionProp, err := common.NavigateValue(opts, []string{"description"}, nil)
+	if err != nil {
+		return err
+	}
+	e, err = common.IsEmptyValue(reflect.ValueOf(descriptionProp))
+	if err != nil {
+		return err
+	}
+	if !e {
+		params["description"] = descriptionProp
+	}
+
 	natGatewayIDProp, err := common.NavigateValue(opts, []string{"nat_gateway_id"}, nil)
 	if err != nil {
 		return err
@@ -328,6 +448,30 @@ func resourceNatDnatRuleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// port ranges and description are not returned by every API version, so missing values are skipped
+	ruleProp, err := common.NavigateValue(res, []string{"read", "dnat_rule"}, nil)
+	if err != nil {
+		return fmt.Errorf("Error reading Dnat:dnat_rule, err: %s", err)
+	}
+	if rule, isMap := ruleProp.(map[string]interface{}); isMap {
+		for _, key := range []string{"internal_service_port_range", "external_service_port_range", "description"} {
+			prop, ok := opts[key]
+			if prop != nil {
+				ok, _ = common.IsEmptyValue(reflect.ValueOf(prop))
+				ok = !ok
+			}
+			if ok {
+				continue
+			}
+			if prop, ok = rule[key]; !ok {
+				continue
+			}
+			if err = d.Set(key, prop); err != nil {
+				return fmt.Errorf("Error setting Dnat:%s, err: %s", key, err)
+			}
+		}
+	}
+
 	natGatewayIDProp, ok := opts["nat_gateway_id"]
 	if natGatewayIDProp != nil {
 		ok, _ = common.IsEmptyValue(reflect.ValueOf(natGatewayIDProp))
@@ -22,6 +22,10 @@ func ResourceNatGatewayV2() *schema.Resource {
 		Update: resourceNatGatewayV2Update,
 		Delete: resourceNatGatewayV2Delete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -3,10 +3,15 @@ package nat
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/snatrules"
@@ -21,6 +26,10 @@ func ResourceNatSnatRuleV2() *schema.Resource {
 		Read:   resourceNatSnatRuleV2Read,
 		Delete: resourceNatSnatRuleV2Delete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -50,19 +59,42 @@ func ResourceNatSnatRuleV2() *schema.Resource {
 				ValidateFunc: common.ValidateCIDR,
 			},
 			"source_type": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
 			},
 			"floating_ip_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressFloatingIPIDsDiff,
+			},
+			"floating_ip_address": {
 				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Computed: true,
 			},
 		},
 	}
 }
 
+// splitFloatingIPIDs returns sorted EIP IDs from the comma-separated `floating_ip_id` value
+func splitFloatingIPIDs(ids string) []string {
+	var result []string
+	for _, id := range strings.Split(ids, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// suppressFloatingIPIDsDiff ignores the order of EIP IDs and spaces around them
+func suppressFloatingIPIDsDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.Join(splitFloatingIPIDs(old), ",") == strings.Join(splitFloatingIPIDs(new), ",")
+}
+
 func resourceNatSnatRuleV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	_, net_ok := d.GetOk("network_id")
@@ -79,7 +111,7 @@ func resourceNatSnatRuleV2Create(d *schema.ResourceData, meta interface{}) error
 	createOpts := &snatrules.CreateOpts{
 		NatGatewayID: d.Get("nat_gateway_id").(string),
 		NetworkID:    d.Get("network_id").(string),
-		FloatingIPID: d.Get("floating_ip_id").(string),
+		FloatingIPID: strings.Join(splitFloatingIPIDs(d.Get("floating_ip_id").(string)), ","),
 		SourceType:   d.Get("source_type").(int),
 		Cidr:         d.Get("cidr").(string),
 	}
@@ -122,13 +154,26 @@ func resourceNatSnatRuleV2Read(d *schema.ResourceData, meta interface{}) error {
 		return common.CheckDeleted(d, err, "Snat Rule")
 	}
 
-	d.Set("nat_gateway_id", snatRule.NatGatewayID)
-	d.Set("network_id", snatRule.NetworkID)
-	d.Set("floating_ip_id", snatRule.FloatingIPID)
-	d.Set("source_type", snatRule.SourceType)
-	d.Set("cidr", snatRule.Cidr)
+	sourceType := 0
+	if snatRule.SourceType != "" {
+		sourceType, err = strconv.Atoi(snatRule.SourceType)
+		if err != nil {
+			return fmt.Errorf("error parsing source_type of Snat Rule %s: %s", d.Id(), err)
+		}
+	}
 
-	d.Set("region", config.GetRegion(d))
+	mErr := multierror.Append(nil,
+		d.Set("nat_gateway_id", snatRule.NatGatewayID),
+		d.Set("network_id", snatRule.NetworkID),
+		d.Set("floating_ip_id", snatRule.FloatingIPID),
+		d.Set("floating_ip_address", snatRule.FloatingIPAddress),
+		d.Set("source_type", sourceType),
+		d.Set("cidr", snatRule.Cidr),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting Snat Rule fields: %s", err)
+	}
 
 	return nil
 }
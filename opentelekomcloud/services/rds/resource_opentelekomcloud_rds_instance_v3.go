@@ -3,12 +3,14 @@ package rds
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
@@ -174,6 +176,31 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"reboot_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(maintenanceTimeRegex, "must be set in the `hh:00` format"),
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(maintenanceTimeRegex, "must be set in the `hh:00` format"),
+						},
+					},
+				},
+			},
 			"created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -226,6 +253,8 @@ func ResourceRdsInstanceV3() *schema.Resource {
 	}
 }
 
+var maintenanceTimeRegex = regexp.MustCompile(`^([01]\d|2[0-3]):00$`)
+
 func resourceRDSDataStore(d *schema.ResourceData) *instances.Datastore {
 	dataStoreRaw := d.Get("db").([]interface{})[0].(map[string]interface{})
 	dataStore := instances.Datastore{
@@ -257,6 +286,19 @@ func resourceRDSBackupStrategy(d *schema.ResourceData) *instances.BackupStrategy
 	return &backupStrategy
 }
 
+func resourceRDSMaintenanceWindow(d *schema.ResourceData) *MaintenanceWindowOpts {
+	maintenanceWindowRaw := d.Get("maintenance_window").([]interface{})
+	if len(maintenanceWindowRaw) == 0 {
+		return nil
+	}
+	maintenanceWindowInfo := maintenanceWindowRaw[0].(map[string]interface{})
+	maintenanceWindow := MaintenanceWindowOpts{
+		StartTime: maintenanceWindowInfo["start_time"].(string),
+		EndTime:   maintenanceWindowInfo["end_time"].(string),
+	}
+	return &maintenanceWindow
+}
+
 func resourceRDSHa(d *schema.ResourceData) *instances.Ha {
 	replicationMode := d.Get("ha_replication_mode").(string)
 	if replicationMode == "" {
@@ -343,6 +385,12 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(r.Instance.Id)
 
+	if maintenanceWindow := resourceRDSMaintenanceWindow(d); maintenanceWindow != nil {
+		if err := UpdateMaintenanceWindow(client, d.Id(), *maintenanceWindow).ExtractErr(); err != nil {
+			return fmt.Errorf("error setting RDSv3 instance maintenance window: %s", err)
+		}
+	}
+
 	if common.HasFilledOpt(d, "tag") {
 		rdsInstance, err := GetRdsInstance(client, r.Instance.Id)
 		if err != nil {
@@ -525,6 +573,15 @@ func resourceRdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("maintenance_window") {
+		if maintenanceWindow := resourceRDSMaintenanceWindow(d); maintenanceWindow != nil {
+			log.Printf("[DEBUG] Update maintenance window: %#v", maintenanceWindow)
+			if err := UpdateMaintenanceWindow(client, d.Id(), *maintenanceWindow).ExtractErr(); err != nil {
+				return fmt.Errorf("error updating RDSv3 instance maintenance window: %s", err)
+			}
+		}
+	}
+
 	// Fetching node id
 	var nodeID string
 	v, err := GetRdsInstance(client, d.Id())
@@ -691,14 +748,61 @@ func resourceRdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 				d.Id(),
 			},
 		}
-		if err := configurations.Apply(client, newParamGroupID, applyOpts).Err; err != nil {
+		applyResult, err := configurations.Apply(client, newParamGroupID, applyOpts).Extract()
+		if err != nil {
 			return fmt.Errorf("error during apply new configuration: %s", err)
 		}
+
+		restartRequired := false
+		for _, result := range applyResult.ApplyResults {
+			if result.InstanceID == d.Id() && result.RestartRequired {
+				restartRequired = true
+			}
+		}
+		if restartRequired {
+			if d.Get("reboot_on_parameter_change").(bool) {
+				if err := restartRdsInstanceV3(d, client); err != nil {
+					return err
+				}
+			} else {
+				log.Printf("[WARN] RDSv3 instance %s has to be rebooted to apply new configuration", d.Id())
+			}
+		}
 	}
 
 	return resourceRdsInstanceV3Read(d, meta)
 }
 
+func waitForRdsInstanceV3Available(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	return instances.WaitForStateAvailable(client, int(timeout.Seconds()), d.Id())
+}
+
+func restartRdsInstanceV3(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	log.Printf("Restart could be done only in status `available`")
+	if err := waitForRdsInstanceV3Available(d, client); err != nil {
+		return fmt.Errorf("error waiting for RDSv3 instance to become available: %s", err)
+	}
+
+	log.Printf("[DEBUG] Restarting RDSv3 instance %s", d.Id())
+	restartResult, err := instances.Restart(client, RestartOpts{}, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("error restarting RDSv3 instance: %s", err)
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if err := instances.WaitForJobCompleted(client, int(timeout.Seconds()), restartResult.JobId); err != nil {
+		return err
+	}
+
+	log.Printf("Waiting for RDSv3 become in status `available`")
+	if err := waitForRdsInstanceV3Available(d, client); err != nil {
+		return fmt.Errorf("error waiting for RDSv3 instance to become available after restart: %s", err)
+	}
+
+	log.Printf("[DEBUG] Successfully restarted RDSv3 instance %s", d.Id())
+	return nil
+}
+
 func getMasterID(nodes []instances.Nodes) (nodeID string) {
 	for _, node := range nodes {
 		if node.Role == "master" {
@@ -761,6 +865,17 @@ func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting backup strategy: %s", err)
 	}
 
+	var maintenanceWindowList []map[string]interface{}
+	if window := strings.Split(rdsInstance.MaintenanceWindow, "-"); len(window) == 2 {
+		maintenanceWindow := make(map[string]interface{})
+		maintenanceWindow["start_time"] = window[0]
+		maintenanceWindow["end_time"] = window[1]
+		maintenanceWindowList = append(maintenanceWindowList, maintenanceWindow)
+	}
+	if err := d.Set("maintenance_window", maintenanceWindowList); err != nil {
+		return fmt.Errorf("error setting maintenance window: %s", err)
+	}
+
 	var volumeList []map[string]interface{}
 	volume := make(map[string]interface{})
 	volume["size"] = rdsInstance.Volume.Size
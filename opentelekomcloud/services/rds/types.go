@@ -0,0 +1,41 @@
+package rds
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RestartOpts represents the body of the RDSv3 instance reboot action.
+// It overrides instances.RestartRdsInstanceOpts which can't send empty `restart` object.
+type RestartOpts struct{}
+
+// ToRestartRdsInstanceMap builds a request body for instances.Restart.
+func (opts RestartOpts) ToRestartRdsInstanceMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"restart": map[string]interface{}{},
+	}, nil
+}
+
+// MaintenanceWindowOpts represents the attributes used when changing the maintenance window.
+type MaintenanceWindowOpts struct {
+	StartTime string `json:"start_time" required:"true"`
+	EndTime   string `json:"end_time" required:"true"`
+}
+
+// ToMaintenanceWindowMap builds a request body from MaintenanceWindowOpts.
+func (opts MaintenanceWindowOpts) ToMaintenanceWindowMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateMaintenanceWindow changes the maintenance window of the RDSv3 instance.
+func UpdateMaintenanceWindow(client *golangsdk.ServiceClient, instanceID string, opts MaintenanceWindowOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToMaintenanceWindowMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url := client.ServiceURL("instances", instanceID, "ops-window")
+	_, r.Err = client.Put(url, b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 )
 
@@ -27,6 +28,7 @@ func DataSourceVpcSubnetIdsV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tags": common.TagsSchema(),
 			"ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -53,15 +55,20 @@ func dataSourceVpcSubnetIdsV1Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Unable to retrieve subnets: %s", err)
 	}
 
-	if len(refinedSubnets) == 0 {
-		return fmt.Errorf("no matching subnet found for vpc with id %s", d.Get("vpc_id").(string))
-	}
-
 	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	refinedSubnets, err = filterSubnetsByTags(networkingClient, refinedSubnets, d.Get("tags").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if len(refinedSubnets) == 0 {
+		return fmt.Errorf("no matching subnet found for vpc with id %s", d.Get("vpc_id").(string))
+	}
+
 	sortedSubnets := make([]SubnetIP, 0)
 	for _, subnet := range refinedSubnets {
 		net, err := networkipavailabilities.Get(networkingClient, subnet.ID).Extract()
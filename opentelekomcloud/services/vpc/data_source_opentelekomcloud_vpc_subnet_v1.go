@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 )
 
@@ -73,6 +74,19 @@ func DataSourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags": common.TagsSchema(),
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -101,6 +115,16 @@ func dataSourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve subnets: %s", err)
 	}
 
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	refinedSubnets, err = filterSubnetsByTags(networkingClient, refinedSubnets, d.Get("tags").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
 	if refinedSubnets == nil || len(refinedSubnets) == 0 {
 		return fmt.Errorf("No matching subnet found. " +
 			"Please change your search criteria and try again.")
@@ -128,5 +152,13 @@ func dataSourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("subnet_id", Subnets.SubnetId)
 	d.Set("region", config.GetRegion(d))
 
+	subnet, err := GetVpcSubnetV1(subnetClient, Subnets.ID)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve IPv6 details of subnet %s: %s", Subnets.ID, err)
+	}
+	d.Set("ipv6_enable", subnet.IPv6Enable)
+	d.Set("ipv6_cidr", subnet.CIDRV6)
+	d.Set("ipv6_gateway", subnet.GatewayIPV6)
+
 	return nil
 }
@@ -113,10 +113,8 @@ func resourceNetworkingSecGroupRuleV2Create(d *schema.ResourceData, meta interfa
 	portRangeMax := d.Get("port_range_max").(int)
 	protocol := d.Get("protocol").(string)
 
-	if protocol == "" {
-		if portRangeMin != 0 || portRangeMax != 0 {
-			return fmt.Errorf("A protocol must be specified when using port_range_min and port_range_max")
-		}
+	if err := validateSecGroupRuleV2Ports(protocol, portRangeMin, portRangeMax); err != nil {
+		return err
 	}
 
 	opts := rules.CreateOpts{
@@ -215,6 +213,16 @@ func resourceNetworkingSecGroupRuleV2Delete(d *schema.ResourceData, meta interfa
 	return err
 }
 
+// validateSecGroupRuleV2Ports checks that the port range is used only together with the protocol
+func validateSecGroupRuleV2Ports(protocol string, portRangeMin, portRangeMax int) error {
+	if protocol == "" {
+		if portRangeMin != 0 || portRangeMax != 0 {
+			return fmt.Errorf("A protocol must be specified when using port_range_min and port_range_max")
+		}
+	}
+	return nil
+}
+
 func resourceNetworkingSecGroupRuleV2DetermineDirection(v string) rules.RuleDirection {
 	var direction rules.RuleDirection
 	switch v {
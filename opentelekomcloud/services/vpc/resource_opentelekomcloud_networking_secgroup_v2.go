@@ -1,13 +1,17 @@
 package vpc
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
@@ -32,6 +36,8 @@ func ResourceNetworkingSecGroupV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: validateSecGroupV2Rules,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -59,10 +65,153 @@ func ResourceNetworkingSecGroupV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      secGroupV2RuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+						},
+						"ethertype": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IPv4", "IPv6"}, false),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port_range_min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"port_range_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// secGroupV2RuleHash ignores the computed rule ID, so rules are identified by the configuration only
+func secGroupV2RuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["direction"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ethertype"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["protocol"].(string))))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_min"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_max"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["remote_ip_prefix"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["remote_group_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func validateSecGroupV2Rules(d *schema.ResourceDiff, _ interface{}) error {
+	var mErr *multierror.Error
+	for _, v := range d.Get("rule").(*schema.Set).List() {
+		rule := v.(map[string]interface{})
+		err := validateSecGroupRuleV2Ports(
+			rule["protocol"].(string), rule["port_range_min"].(int), rule["port_range_max"].(int),
+		)
+		if err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("invalid rule %s/%s: %s", rule["direction"], rule["ethertype"], err))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+func flattenSecGroupV2Rules(secGroupRules []rules.SecGroupRule) []interface{} {
+	result := make([]interface{}, len(secGroupRules))
+	for i, rule := range secGroupRules {
+		result[i] = map[string]interface{}{
+			"direction":        rule.Direction,
+			"ethertype":        rule.EtherType,
+			"protocol":         rule.Protocol,
+			"port_range_min":   rule.PortRangeMin,
+			"port_range_max":   rule.PortRangeMax,
+			"remote_ip_prefix": rule.RemoteIPPrefix,
+			"remote_group_id":  rule.RemoteGroupID,
+			"description":      rule.Description,
+			"id":               rule.ID,
+		}
+	}
+	return result
+}
+
+func getSecGroupV2RuleCreateOpts(secGroupID string, rule map[string]interface{}) rules.CreateOpts {
+	return rules.CreateOpts{
+		Direction:      resourceNetworkingSecGroupRuleV2DetermineDirection(rule["direction"].(string)),
+		EtherType:      resourceNetworkingSecGroupRuleV2DetermineEtherType(rule["ethertype"].(string)),
+		Protocol:       resourceNetworkingSecGroupRuleV2DetermineProtocol(rule["protocol"].(string)),
+		PortRangeMin:   rule["port_range_min"].(int),
+		PortRangeMax:   rule["port_range_max"].(int),
+		RemoteIPPrefix: rule["remote_ip_prefix"].(string),
+		RemoteGroupID:  rule["remote_group_id"].(string),
+		Description:    rule["description"].(string),
+		SecGroupID:     secGroupID,
+	}
+}
+
+// reconcileSecGroupV2Rules makes the rules of the security group match the configured rule set:
+// existing rules missing from the configuration are deleted, configured rules missing from
+// the security group are created, unchanged rules are left intact
+func reconcileSecGroupV2Rules(client *golangsdk.ServiceClient, secGroupID string, expected *schema.Set) error {
+	securityGroup, err := groups.Get(client, secGroupID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud Neutron Security Group %s: %s", secGroupID, err)
+	}
+	existing := schema.NewSet(secGroupV2RuleHash, flattenSecGroupV2Rules(securityGroup.Rules))
+
+	for _, v := range existing.Difference(expected).List() {
+		ruleID := v.(map[string]interface{})["id"].(string)
+		log.Printf("[DEBUG] Deleting rule %s of security group %s", ruleID, secGroupID)
+		if err := rules.Delete(client, ruleID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("error deleting rule %s of security group %s: %s", ruleID, secGroupID, err)
+			}
+		}
+	}
+
+	for _, v := range expected.Difference(existing).List() {
+		opts := getSecGroupV2RuleCreateOpts(secGroupID, v.(map[string]interface{}))
+		log.Printf("[DEBUG] Creating rule of security group %s: %#v", secGroupID, opts)
+		if _, err := rules.Create(client, opts).Extract(); err != nil {
+			return fmt.Errorf("error creating rule of security group %s: %s", secGroupID, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceNetworkingSecGroupV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
@@ -100,6 +249,12 @@ func resourceNetworkingSecGroupV2Create(d *schema.ResourceData, meta interface{}
 
 	d.SetId(securityGroup.ID)
 
+	if ruleSet, ok := d.GetOk("rule"); ok {
+		if err := reconcileSecGroupV2Rules(networkingClient, securityGroup.ID, ruleSet.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	return resourceNetworkingSecGroupV2Read(d, meta)
 }
 
@@ -122,6 +277,7 @@ func resourceNetworkingSecGroupV2Read(d *schema.ResourceData, meta interface{})
 		d.Set("tenant_id", securityGroup.TenantID),
 		d.Set("name", securityGroup.Name),
 		d.Set("region", config.GetRegion(d)),
+		d.Set("rule", schema.NewSet(secGroupV2RuleHash, flattenSecGroupV2Rules(securityGroup.Rules))),
 	)
 
 	return me.ErrorOrNil()
@@ -133,20 +289,29 @@ func resourceNetworkingSecGroupV2Update(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return fmt.Errorf("error creating OpenTelekomCloud Networkingv2 client: %s", err)
 	}
-	var updateOpts groups.UpdateOpts
 
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
-	}
+	if d.HasChanges("name", "description") {
+		var updateOpts groups.UpdateOpts
+
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+
+		if d.HasChange("description") {
+			updateOpts.Description = d.Get("description").(string)
+		}
 
-	if d.HasChange("description") {
-		updateOpts.Description = d.Get("description").(string)
+		log.Printf("[DEBUG] Updating SecGroup %s with options: %#v", d.Id(), updateOpts)
+		_, err = groups.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud networking SecGroup: %s", err)
+		}
 	}
 
-	log.Printf("[DEBUG] Updating SecGroup %s with options: %#v", d.Id(), updateOpts)
-	_, err = groups.Update(networkingClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("error updating OpenTelekomCloud networking SecGroup: %s", err)
+	if d.HasChange("rule") {
+		if err := reconcileSecGroupV2Rules(networkingClient, d.Id(), d.Get("rule").(*schema.Set)); err != nil {
+			return err
+		}
 	}
 
 	return resourceNetworkingSecGroupV2Read(d, meta)
@@ -0,0 +1,190 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	bandwidthsv1 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceBandWidthAssociateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBandWidthAssociateV2Create,
+		Read:   resourceBandWidthAssociateV2Read,
+		Update: resourceBandWidthAssociateV2Update,
+		Delete: resourceBandWidthAssociateV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"floating_ips": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"backup_charge_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "bandwidth",
+				ValidateFunc: validation.StringInSlice([]string{"bandwidth", "traffic"}, false),
+			},
+			"backup_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+		},
+	}
+}
+
+func bandWidthPublicIPs(ids []interface{}) []bandwidths.PublicIpInfoID {
+	result := make([]bandwidths.PublicIpInfoID, len(ids))
+	for i, id := range ids {
+		result[i] = bandwidths.PublicIpInfoID{PublicIPID: id.(string)}
+	}
+	return result
+}
+
+func insertEIPsToBandWidth(client *golangsdk.ServiceClient, bandwidthID string, ids []interface{}) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	opts := bandwidths.BandWidthInsertOpts{PublicipInfo: bandWidthPublicIPs(ids)}
+	log.Printf("[DEBUG] Adding EIPs to bandwidth %s: %#v", bandwidthID, opts)
+	if _, err := bandwidths.Insert(client, bandwidthID, opts).Extract(); err != nil {
+		return fmt.Errorf("error adding EIPs to shared bandwidth %s: %s", bandwidthID, err)
+	}
+	return nil
+}
+
+// removeEIPsFromBandWidth moves the EIPs out of the shared bandwidth,
+// each of them gets a new dedicated bandwidth of the given size and charge mode
+func removeEIPsFromBandWidth(client *golangsdk.ServiceClient, bandwidthID string, ids []interface{}, chargeMode string, size int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	opts := bandwidths.BandWidthRemoveOpts{
+		ChargeMode:   chargeMode,
+		Size:         &size,
+		PublicipInfo: bandWidthPublicIPs(ids),
+	}
+	log.Printf("[DEBUG] Removing EIPs from bandwidth %s: %#v", bandwidthID, opts)
+	if err := bandwidths.Remove(client, bandwidthID, opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error removing EIPs from shared bandwidth %s: %s", bandwidthID, err)
+	}
+	return nil
+}
+
+func resourceBandWidthAssociateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	bandwidthID := d.Get("bandwidth").(string)
+	if err := insertEIPsToBandWidth(client, bandwidthID, d.Get("floating_ips").(*schema.Set).List()); err != nil {
+		return err
+	}
+	d.SetId(bandwidthID)
+
+	return resourceBandWidthAssociateV2Read(d, meta)
+}
+
+func resourceBandWidthAssociateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	bandwidth, err := bandwidthsv1.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "shared bandwidth")
+	}
+
+	// only the managed EIPs are reported, other EIPs of the shared bandwidth are ignored,
+	// all of them are adopted on import when nothing is managed yet
+	managed := d.Get("floating_ips").(*schema.Set)
+	var ids []string
+	for _, info := range bandwidth.PublicipInfo {
+		if managed.Len() == 0 || managed.Contains(info.PublicipId) {
+			ids = append(ids, info.PublicipId)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("bandwidth", d.Id()),
+		d.Set("floating_ips", ids),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting bandwidth association fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBandWidthAssociateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	if d.HasChange("floating_ips") {
+		oldRaw, newRaw := d.GetChange("floating_ips")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		err := removeEIPsFromBandWidth(client, d.Id(), oldSet.Difference(newSet).List(),
+			d.Get("backup_charge_mode").(string), d.Get("backup_size").(int))
+		if err != nil {
+			return err
+		}
+		if err := insertEIPsToBandWidth(client, d.Id(), newSet.Difference(oldSet).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceBandWidthAssociateV2Read(d, meta)
+}
+
+func resourceBandWidthAssociateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	err = removeEIPsFromBandWidth(client, d.Id(), d.Get("floating_ips").(*schema.Set).List(),
+		d.Get("backup_charge_mode").(string), d.Get("backup_size").(int))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,204 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	bandwidthsv1 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceBandWidthV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBandWidthV2Create,
+		Read:   resourceBandWidthV2Read,
+		Update: resourceBandWidthV2Update,
+		Delete: resourceBandWidthV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(5, 2000),
+			},
+			"share_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"charge_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBandWidthV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	size := d.Get("size").(int)
+	createOpts := bandwidths.CreateOpts{
+		Name: d.Get("name").(string),
+		Size: &size,
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	bandwidth, err := bandwidths.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating shared bandwidth: %s", err)
+	}
+	d.SetId(bandwidth.ID)
+
+	v1Client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"NORMAL"},
+		Refresh:    getBandWidthV2Status(v1Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for shared bandwidth %s to become ready: %s", d.Id(), err)
+	}
+
+	return resourceBandWidthV2Read(d, meta)
+}
+
+func resourceBandWidthV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	bandwidth, err := bandwidthsv1.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "shared bandwidth")
+	}
+	log.Printf("[DEBUG] Retrieved bandwidth %s: %#v", d.Id(), bandwidth)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", bandwidth.Name),
+		d.Set("size", bandwidth.Size),
+		d.Set("share_type", bandwidth.ShareType),
+		d.Set("bandwidth_type", bandwidth.BandwidthType),
+		d.Set("charge_mode", bandwidth.ChargeMode),
+		d.Set("status", bandwidth.Status),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting shared bandwidth fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBandWidthV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	if d.HasChanges("name", "size") {
+		updateOpts := bandwidthsv1.UpdateOpts{
+			Name: d.Get("name").(string),
+			Size: d.Get("size").(int),
+		}
+		log.Printf("[DEBUG] Updating bandwidth %s with options: %#v", d.Id(), updateOpts)
+		if _, err := bandwidthsv1.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("error updating shared bandwidth %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBandWidthV2Read(d, meta)
+}
+
+func resourceBandWidthV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	if err := bandwidths.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting shared bandwidth")
+	}
+
+	v1Client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NORMAL", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    getBandWidthV2Status(v1Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for shared bandwidth %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getBandWidthV2Status(client *golangsdk.ServiceClient, bandwidthID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		bandwidth, err := bandwidthsv1.Get(client, bandwidthID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return bandwidth, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return bandwidth, bandwidth.Status, nil
+	}
+}
@@ -94,6 +94,10 @@ func ResourceVpcEIPV1() *schema.Resource {
 					},
 				},
 			},
+			"bandwidth_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"value_specs": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -174,16 +178,24 @@ func resourceVpcEIPV1Read(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Set bandwidth
-	bw := []map[string]interface{}{
-		{
-			"name":        bandWidth.Name,
-			"size":        eip.BandwidthSize,
-			"share_type":  eip.BandwidthShareType,
-			"charge_mode": bandWidth.ChargeMode,
-		},
+	// Set bandwidth, the dedicated bandwidth configuration is kept while the EIP is
+	// added to a shared bandwidth, so the EIP is not recreated
+	if eip.BandwidthShareType == "WHOLE" && len(d.Get("bandwidth").([]interface{})) != 0 {
+		log.Printf("[DEBUG] EIP %s uses shared bandwidth %s", d.Id(), eip.BandwidthID)
+	} else {
+		bw := []map[string]interface{}{
+			{
+				"name":        bandWidth.Name,
+				"size":        eip.BandwidthSize,
+				"share_type":  eip.BandwidthShareType,
+				"charge_mode": bandWidth.ChargeMode,
+			},
+		}
+		if err := d.Set("bandwidth", bw); err != nil {
+			return err
+		}
 	}
-	if err := d.Set("bandwidth", bw); err != nil {
+	if err := d.Set("bandwidth_id", eip.BandwidthID); err != nil {
 		return err
 	}
 	if err := d.Set("region", config.GetRegion(d)); err != nil {
@@ -219,9 +231,13 @@ func resourceVpcEIPV1Update(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return common.CheckDeleted(d, err, "Error deleting eip")
 		}
-		_, err = bandwidths.Update(client, eip.BandwidthID, updateOpts).Extract()
-		if err != nil {
-			return fmt.Errorf("error updating bandwidth: %s", err)
+		if eip.BandwidthShareType == "WHOLE" {
+			log.Printf("[DEBUG] EIP %s uses shared bandwidth %s, skipping bandwidth update", d.Id(), eip.BandwidthID)
+		} else {
+			_, err = bandwidths.Update(client, eip.BandwidthID, updateOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("error updating bandwidth: %s", err)
+			}
 		}
 
 	}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/opentelekomcloud/gophertelekomcloud"
@@ -30,6 +31,10 @@ func ResourceVpcSubnetV1() *schema.Resource {
 		Read:   resourceVpcSubnetV1Read,
 		Update: resourceVpcSubnetV1Update,
 		Delete: resourceVpcSubnetV1Delete,
+
+		// IPv6 can be enabled on the existing subnet, but can't be disabled
+		CustomizeDiff: customdiff.ForceNewIfChange("ipv6_enable", isIPv6Disabled),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -113,10 +118,27 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func isIPv6Disabled(old, new, _ interface{}) bool {
+	return old.(bool) && !new.(bool)
+}
+
 func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	subnetClient, err := config.NetworkingV1Client(config.GetRegion(d))
@@ -125,16 +147,19 @@ func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	createOpts := subnets.CreateOpts{
-		Name:             d.Get("name").(string),
-		CIDR:             d.Get("cidr").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		GatewayIP:        d.Get("gateway_ip").(string),
-		EnableDHCP:       d.Get("dhcp_enable").(bool),
-		VPC_ID:           d.Get("vpc_id").(string),
-		PRIMARY_DNS:      d.Get("primary_dns").(string),
-		SECONDARY_DNS:    d.Get("secondary_dns").(string),
-		DnsList:          resourceSubnetDNSListV1(d),
+	createOpts := VpcSubnetV1CreateOpts{
+		CreateOpts: subnets.CreateOpts{
+			Name:             d.Get("name").(string),
+			CIDR:             d.Get("cidr").(string),
+			AvailabilityZone: d.Get("availability_zone").(string),
+			GatewayIP:        d.Get("gateway_ip").(string),
+			EnableDHCP:       d.Get("dhcp_enable").(bool),
+			VPC_ID:           d.Get("vpc_id").(string),
+			PRIMARY_DNS:      d.Get("primary_dns").(string),
+			SECONDARY_DNS:    d.Get("secondary_dns").(string),
+			DnsList:          resourceSubnetDNSListV1(d),
+		},
+		IPv6Enable: d.Get("ipv6_enable").(bool),
 	}
 
 	if common.HasFilledOpt(d, "ntp_addresses") {
@@ -196,7 +221,7 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	n, err := subnets.Get(subnetClient, d.Id()).Extract()
+	n, err := GetVpcSubnetV1(subnetClient, d.Id())
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -216,6 +241,9 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("availability_zone", n.AvailabilityZone)
 	d.Set("vpc_id", n.VPC_ID)
 	d.Set("subnet_id", n.SubnetId)
+	d.Set("ipv6_enable", n.IPv6Enable)
+	d.Set("ipv6_cidr", n.CIDRV6)
+	d.Set("ipv6_gateway", n.GatewayIPV6)
 	d.Set("region", config.GetRegion(d))
 
 	for _, opt := range n.ExtraDhcpOpts {
@@ -251,7 +279,7 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	var updateOpts subnets.UpdateOpts
+	var updateOpts VpcSubnetV1UpdateOpts
 
 	// as name is mandatory while updating subnet
 	updateOpts.Name = d.Get("name").(string)
@@ -280,6 +308,10 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		extraDhcpRequests = append(extraDhcpRequests, extraDhcpReq)
 		updateOpts.ExtraDhcpOpts = extraDhcpRequests
 	}
+	if d.HasChange("ipv6_enable") {
+		ipv6Enable := d.Get("ipv6_enable").(bool)
+		updateOpts.IPv6Enable = &ipv6Enable
+	}
 
 	vpc_id := d.Get("vpc_id").(string)
 
@@ -49,6 +49,14 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				ForceNew:     false,
 				ValidateFunc: common.ValidateCIDR,
 			},
+			"secondary_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: common.ValidateCIDR,
+				},
+			},
 			"shared": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -98,6 +106,53 @@ func readNetworkingTags(d *schema.ResourceData, config *cfg.Config, resource str
 	return nil
 }
 
+// updateVpcSecondaryCidrs removes the secondary CIDRs missing in the configuration and adds the new ones
+func updateVpcSecondaryCidrs(d *schema.ResourceData, config *cfg.Config) error {
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("secondary_cidrs")
+	oldSet := oldRaw.(*schema.Set)
+	newSet := newRaw.(*schema.Set)
+
+	if removed := oldSet.Difference(newSet); removed.Len() > 0 {
+		opts := VpcExtendCidrsOpts{ExtendCidrs: common.ExpandToStringSlice(removed.List())}
+		if err := RemoveVpcExtendCidrs(client, d.Id(), opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error removing secondary CIDRs of VPC %s: %s", d.Id(), err)
+		}
+	}
+	if added := newSet.Difference(oldSet); added.Len() > 0 {
+		opts := VpcExtendCidrsOpts{ExtendCidrs: common.ExpandToStringSlice(added.List())}
+		if err := AddVpcExtendCidrs(client, d.Id(), opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error adding secondary CIDRs to VPC %s: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func readVpcSecondaryCidrs(d *schema.ResourceData, config *cfg.Config) error {
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	vpc, err := GetVpcV3(client, d.Id())
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] VPC v3 API is not available, secondary CIDRs of VPC %s are not read", d.Id())
+			return nil
+		}
+		return fmt.Errorf("error retrieving secondary CIDRs of VPC %s: %s", d.Id(), err)
+	}
+
+	if err := d.Set("secondary_cidrs", vpc.ExtendCidrs); err != nil {
+		return fmt.Errorf("error setting secondary CIDRs: %s", err)
+	}
+	return nil
+}
+
 func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	vpcClient, err := config.NetworkingV1Client(config.GetRegion(d))
@@ -147,6 +202,12 @@ func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.Get("secondary_cidrs").(*schema.Set).Len() > 0 {
+		if err := updateVpcSecondaryCidrs(d, config); err != nil {
+			return err
+		}
+	}
+
 	if err := addNetworkingTags(d, config, "vpcs"); err != nil {
 		return err
 	}
@@ -179,6 +240,10 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 	d.Set("shared", n.EnableSharedSnat)
 	d.Set("region", config.GetRegion(d))
 
+	if err := readVpcSecondaryCidrs(d, config); err != nil {
+		return err
+	}
+
 	if err := readNetworkingTags(d, config, "vpcs"); err != nil {
 		return err
 	}
@@ -211,6 +276,12 @@ func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error updating OpenTelekomCloud Vpc: %s", err)
 	}
 
+	if d.HasChange("secondary_cidrs") {
+		if err := updateVpcSecondaryCidrs(d, config); err != nil {
+			return err
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		vpcV2Client, err := config.NetworkingV2Client(config.GetRegion(d))
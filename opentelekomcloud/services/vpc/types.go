@@ -1,7 +1,9 @@
 package vpc
 
 import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+	subnetsv1 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/networks"
@@ -85,3 +87,105 @@ type EIPCreateOpts struct {
 	eips.ApplyOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// VpcV3 represents the VPC fields available only in the VPC v3 API.
+type VpcV3 struct {
+	ID          string   `json:"id"`
+	ExtendCidrs []string `json:"extend_cidrs"`
+}
+
+type vpcV3Result struct {
+	golangsdk.Result
+}
+
+func (r vpcV3Result) extract() (*VpcV3, error) {
+	var s struct {
+		Vpc VpcV3 `json:"vpc"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Vpc, err
+}
+
+// GetVpcV3 retrieves the VPC details using the VPC v3 API.
+func GetVpcV3(client *golangsdk.ServiceClient, id string) (*VpcV3, error) {
+	var r vpcV3Result
+	_, r.Err = client.Get(client.ServiceURL("vpcs", id), &r.Body, nil)
+	return r.extract()
+}
+
+// VpcExtendCidrsOpts represents the secondary CIDRs added to or removed from the VPC.
+type VpcExtendCidrsOpts struct {
+	ExtendCidrs []string `json:"extend_cidrs" required:"true"`
+}
+
+// ToVpcExtendCidrsMap builds a request body from VpcExtendCidrsOpts.
+func (opts VpcExtendCidrsOpts) ToVpcExtendCidrsMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "vpc")
+}
+
+func updateVpcExtendCidrs(client *golangsdk.ServiceClient, id, action string, opts VpcExtendCidrsOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToVpcExtendCidrsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(client.ServiceURL("vpcs", id, action), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// AddVpcExtendCidrs adds secondary CIDRs to the VPC.
+func AddVpcExtendCidrs(client *golangsdk.ServiceClient, id string, opts VpcExtendCidrsOpts) golangsdk.ErrResult {
+	return updateVpcExtendCidrs(client, id, "add-extend-cidr", opts)
+}
+
+// RemoveVpcExtendCidrs removes secondary CIDRs from the VPC.
+func RemoveVpcExtendCidrs(client *golangsdk.ServiceClient, id string, opts VpcExtendCidrsOpts) golangsdk.ErrResult {
+	return updateVpcExtendCidrs(client, id, "remove-extend-cidr", opts)
+}
+
+// VpcSubnetV1CreateOpts represents the attributes used when creating a new VPC subnet.
+type VpcSubnetV1CreateOpts struct {
+	subnetsv1.CreateOpts
+	IPv6Enable bool `json:"ipv6_enable,omitempty"`
+}
+
+// ToSubnetCreateMap casts a CreateOpts struct to a map.
+// It overrides subnets.ToSubnetCreateMap to add the IPv6Enable field.
+func (opts VpcSubnetV1CreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetV1UpdateOpts represents the attributes used when updating the VPC subnet.
+type VpcSubnetV1UpdateOpts struct {
+	subnetsv1.UpdateOpts
+	IPv6Enable *bool `json:"ipv6_enable,omitempty"`
+}
+
+// ToSubnetUpdateMap casts a UpdateOpts struct to a map.
+// It overrides subnets.ToSubnetUpdateMap to add the IPv6Enable field.
+func (opts VpcSubnetV1UpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetV1 represents the VPC subnet including the IPv6 fields.
+type VpcSubnetV1 struct {
+	subnetsv1.Subnet
+	IPv6Enable  bool   `json:"ipv6_enable"`
+	CIDRV6      string `json:"cidr_v6"`
+	GatewayIPV6 string `json:"gateway_ip_v6"`
+}
+
+// GetVpcSubnetV1 retrieves the VPC subnet details including the IPv6 fields.
+func GetVpcSubnetV1(client *golangsdk.ServiceClient, id string) (*VpcSubnetV1, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL(client.ProjectID, "subnets", id), &r.Body, nil)
+
+	var s struct {
+		Subnet VpcSubnetV1 `json:"subnet"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Subnet, err
+}
@@ -1,6 +1,32 @@
 package vpc
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
 
 // This is a global MutexKV for use within this plugin.
 var osMutexKV = mutexkv.NewMutexKV()
+
+// filterSubnetsByTags returns only the subnets having all the expected tags,
+// as the VPC v1 subnet list API doesn't support filtering by tags
+func filterSubnetsByTags(client *golangsdk.ServiceClient, allSubnets []subnets.Subnet, expected map[string]interface{}) ([]subnets.Subnet, error) {
+	if len(expected) == 0 {
+		return allSubnets, nil
+	}
+
+	var filtered []subnets.Subnet
+	for _, subnet := range allSubnets {
+		_, matched, err := common.GetResourceTagsMatch(client, "subnets", subnet.ID, expected)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			filtered = append(filtered, subnet)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,388 @@
+package vpcep
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dns"
+)
+
+func ResourceVPCEPEndpointV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEPEndpointV1Create,
+		Read:   resourceVPCEPEndpointV1Read,
+		Delete: resourceVPCEPEndpointV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceVPCEPEndpointV1ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"enable_dns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dns_record": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(300, 2147483647),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"marker_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dns_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// createVPCEPEndpointDNSRecord creates the A record pointing to the endpoint IP in the private DNS zone,
+// the record ID is saved before waiting, so a failed wait doesn't leave an untracked record
+func createVPCEPEndpointDNSRecord(d *schema.ResourceData, config *cfg.Config, ip string) error {
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	record := d.Get("dns_record").([]interface{})[0].(map[string]interface{})
+	zoneID := record["zone_id"].(string)
+	createOpts := recordsets.CreateOpts{
+		Name:        record["name"].(string),
+		Description: fmt.Sprintf("VPC endpoint %s", d.Id()),
+		Records:     []string{ip},
+		TTL:         record["ttl"].(int),
+		Type:        "A",
+	}
+	log.Printf("[DEBUG] Creating DNS record set for VPC endpoint %s: %#v", d.Id(), createOpts)
+	recordSet, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating DNS record set for VPC endpoint %s: %s", d.Id(), err)
+	}
+
+	record["id"] = recordSet.ID
+	if err := d.Set("dns_record", []interface{}{record}); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dns.WaitForDNSRecordSet(dnsClient, zoneID, recordSet.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DNS record set %s to become ACTIVE: %s", recordSet.ID, err)
+	}
+	return nil
+}
+
+// readVPCEPEndpointDNSRecord refreshes the DNS record set of the endpoint,
+// the block is cleared when the record set is gone
+func readVPCEPEndpointDNSRecord(d *schema.ResourceData, config *cfg.Config) error {
+	records := d.Get("dns_record").([]interface{})
+	if len(records) == 0 {
+		return nil
+	}
+	record := records[0].(map[string]interface{})
+	zoneID, recordSetID := record["zone_id"].(string), record["id"].(string)
+	if recordSetID == "" {
+		return nil
+	}
+
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	recordSet, err := recordsets.Get(dnsClient, zoneID, recordSetID).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] DNS record set %s of VPC endpoint %s is gone", recordSetID, d.Id())
+			return d.Set("dns_record", nil)
+		}
+		return fmt.Errorf("error retrieving DNS record set %s of VPC endpoint %s: %s", recordSetID, d.Id(), err)
+	}
+	log.Printf("[DEBUG] Retrieved DNS record set %s: %#v", recordSetID, recordSet)
+
+	return d.Set("dns_record", []interface{}{
+		map[string]interface{}{
+			"zone_id": recordSet.ZoneID,
+			"name":    recordSet.Name,
+			"ttl":     recordSet.TTL,
+			"id":      recordSet.ID,
+		},
+	})
+}
+
+func deleteVPCEPEndpointDNSRecord(d *schema.ResourceData, config *cfg.Config) error {
+	records := d.Get("dns_record").([]interface{})
+	if len(records) == 0 {
+		return nil
+	}
+	record := records[0].(map[string]interface{})
+	zoneID, recordSetID := record["zone_id"].(string), record["id"].(string)
+	if recordSetID == "" {
+		return nil
+	}
+
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	if err := recordsets.Delete(dnsClient, zoneID, recordSetID).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("error deleting DNS record set %s of VPC endpoint %s: %s", recordSetID, d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:    dns.WaitForDNSRecordSet(dnsClient, zoneID, recordSetID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DNS record set %s to be deleted: %s", recordSetID, err)
+	}
+	return nil
+}
+
+func resourceVPCEPEndpointV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	enableDNS := d.Get("enable_dns").(bool)
+	createOpts := EndpointCreateOpts{
+		ServiceID: d.Get("service_id").(string),
+		VpcID:     d.Get("vpc_id").(string),
+		SubnetID:  d.Get("subnet_id").(string),
+		PortIP:    d.Get("port_ip").(string),
+		EnableDNS: &enableDNS,
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	endpoint, err := CreateEndpoint(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating VPC endpoint: %s", err)
+	}
+	d.SetId(endpoint.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"accepted", "pendingAcceptance"},
+		Refresh:    waitForVPCEPEndpointStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for VPC endpoint %s to become ready: %s", d.Id(), err)
+	}
+
+	if len(d.Get("dns_record").([]interface{})) != 0 {
+		if err := createVPCEPEndpointDNSRecord(d, config, result.(*Endpoint).IP); err != nil {
+			return err
+		}
+	}
+
+	return resourceVPCEPEndpointV1Read(d, meta)
+}
+
+func resourceVPCEPEndpointV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	endpoint, err := GetEndpoint(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "VPC endpoint")
+	}
+	log.Printf("[DEBUG] Retrieved VPC endpoint %s: %#v", d.Id(), endpoint)
+
+	mErr := multierror.Append(nil,
+		d.Set("service_id", endpoint.ServiceID),
+		d.Set("vpc_id", endpoint.VpcID),
+		d.Set("subnet_id", endpoint.SubnetID),
+		d.Set("port_ip", endpoint.IP),
+		d.Set("enable_dns", endpoint.EnableDNS),
+		d.Set("service_name", endpoint.ServiceName),
+		d.Set("service_type", endpoint.ServiceType),
+		d.Set("marker_id", endpoint.MarkerID),
+		d.Set("dns_names", endpoint.DNSNames),
+		d.Set("status", endpoint.Status),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting VPC endpoint fields: %s", err)
+	}
+
+	return readVPCEPEndpointDNSRecord(d, config)
+}
+
+func resourceVPCEPEndpointV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	if err := deleteVPCEPEndpointDNSRecord(d, config); err != nil {
+		return err
+	}
+
+	if err := DeleteEndpoint(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting VPC endpoint")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"accepted", "pendingAcceptance", "rejected", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    waitForVPCEPEndpointStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for VPC endpoint %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVPCEPEndpointV1ImportState imports the endpoint either by `<id>`
+// or together with its DNS record set by `<id>/<zone_id>/<record_id>`
+func resourceVPCEPEndpointV1ImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	switch len(parts) {
+	case 1:
+		return []*schema.ResourceData{d}, nil
+	case 3:
+		d.SetId(parts[0])
+		record := map[string]interface{}{
+			"zone_id": parts[1],
+			"id":      parts[2],
+		}
+		if err := d.Set("dns_record", []interface{}{record}); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf("invalid format specified for VPC endpoint, must be <id> or <id>/<zone_id>/<record_id>")
+	}
+}
+
+func waitForVPCEPEndpointStatus(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		endpoint, err := GetEndpoint(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return endpoint, "deleted", nil
+			}
+			return nil, "", err
+		}
+		if endpoint.Status == "failed" {
+			return endpoint, endpoint.Status, fmt.Errorf("VPC endpoint %s is in failed status", id)
+		}
+		return endpoint, endpoint.Status, nil
+	}
+}
@@ -0,0 +1,376 @@
+package vpcep
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceVPCEPServiceV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEPServiceV1Create,
+		Read:   resourceVPCEPServiceV1Read,
+		Update: resourceVPCEPServiceV1Update,
+		Delete: resourceVPCEPServiceV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 16),
+			},
+			"server_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"VM", "VIP", "LB",
+				}, false),
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"approval_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"port": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"server_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "TCP",
+							ValidateFunc: validation.StringInSlice([]string{"TCP"}, false),
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"marker_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getVPCEPServicePorts(d *schema.ResourceData) []PortMapping {
+	portsRaw := d.Get("port").([]interface{})
+	ports := make([]PortMapping, len(portsRaw))
+	for i, v := range portsRaw {
+		port := v.(map[string]interface{})
+		ports[i] = PortMapping{
+			ClientPort: port["client_port"].(int),
+			ServerPort: port["server_port"].(int),
+			Protocol:   port["protocol"].(string),
+		}
+	}
+	return ports
+}
+
+func updateVPCEPServicePermissions(client *golangsdk.ServiceClient, id, action string, permissions []interface{}) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+	opts := PermissionActionOpts{
+		Permissions: common.ExpandToStringSlice(permissions),
+		Action:      action,
+	}
+	log.Printf("[DEBUG] Updating permissions of VPC endpoint service %s: %#v", id, opts)
+	if err := UpdateServicePermissions(client, id, opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error updating permissions of VPC endpoint service %s: %s", id, err)
+	}
+	return nil
+}
+
+func resourceVPCEPServiceV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	approvalEnabled := d.Get("approval_enabled").(bool)
+	createOpts := ServiceCreateOpts{
+		PortID:          d.Get("port_id").(string),
+		VpcID:           d.Get("vpc_id").(string),
+		ServerType:      d.Get("server_type").(string),
+		ServiceName:     d.Get("name").(string),
+		ServiceType:     "interface",
+		ApprovalEnabled: &approvalEnabled,
+		Ports:           getVPCEPServicePorts(d),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	service, err := CreateService(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating VPC endpoint service: %s", err)
+	}
+	d.SetId(service.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    waitForVPCEPServiceStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for VPC endpoint service %s to become available: %s", d.Id(), err)
+	}
+
+	permissions := d.Get("permissions").(*schema.Set).List()
+	if err := updateVPCEPServicePermissions(client, d.Id(), "add", permissions); err != nil {
+		return err
+	}
+
+	return resourceVPCEPServiceV1Read(d, meta)
+}
+
+func resourceVPCEPServiceV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	service, err := GetService(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "VPC endpoint service")
+	}
+	log.Printf("[DEBUG] Retrieved VPC endpoint service %s: %#v", d.Id(), service)
+
+	ports := make([]map[string]interface{}, len(service.Ports))
+	for i, port := range service.Ports {
+		ports[i] = map[string]interface{}{
+			"client_port": port.ClientPort,
+			"server_port": port.ServerPort,
+			"protocol":    port.Protocol,
+		}
+	}
+
+	permissions, err := ListServicePermissions(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving permissions of VPC endpoint service %s: %s", d.Id(), err)
+	}
+	permissionList := make([]string, len(permissions))
+	for i, permission := range permissions {
+		permissionList[i] = permission.Permission
+	}
+
+	connections, err := ListServiceConnections(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving connections of VPC endpoint service %s: %s", d.Id(), err)
+	}
+	connectionList := make([]map[string]interface{}, len(connections))
+	for i, connection := range connections {
+		connectionList[i] = map[string]interface{}{
+			"endpoint_id": connection.ID,
+			"marker_id":   connection.MarkerID,
+			"domain_id":   connection.DomainID,
+			"status":      connection.Status,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", getVPCEPServiceShortName(service.ServiceName)),
+		d.Set("service_name", service.ServiceName),
+		d.Set("server_type", service.ServerType),
+		d.Set("port_id", service.PortID),
+		d.Set("vpc_id", service.VpcID),
+		d.Set("approval_enabled", service.ApprovalEnabled),
+		d.Set("port", ports),
+		d.Set("permissions", permissionList),
+		d.Set("service_type", service.ServiceType),
+		d.Set("status", service.Status),
+		d.Set("connections", connectionList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting VPC endpoint service fields: %s", err)
+	}
+
+	return nil
+}
+
+// getVPCEPServiceShortName returns the configured name from the full service name,
+// which has the `<region>.<name>.<id>` format
+func getVPCEPServiceShortName(serviceName string) string {
+	parts := strings.Split(serviceName, ".")
+	if len(parts) == 3 {
+		return parts[1]
+	}
+	return serviceName
+}
+
+func resourceVPCEPServiceV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	if d.HasChanges("name", "approval_enabled", "port_id", "port") {
+		var updateOpts ServiceUpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.ServiceName = &name
+		}
+		if d.HasChange("approval_enabled") {
+			approvalEnabled := d.Get("approval_enabled").(bool)
+			updateOpts.ApprovalEnabled = &approvalEnabled
+		}
+		if d.HasChange("port_id") {
+			updateOpts.PortID = d.Get("port_id").(string)
+		}
+		if d.HasChange("port") {
+			updateOpts.Ports = getVPCEPServicePorts(d)
+		}
+
+		log.Printf("[DEBUG] Updating VPC endpoint service %s with options: %#v", d.Id(), updateOpts)
+		if _, err := UpdateService(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating VPC endpoint service %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permissions") {
+		oldRaw, newRaw := d.GetChange("permissions")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		if err := updateVPCEPServicePermissions(client, d.Id(), "remove", oldSet.Difference(newSet).List()); err != nil {
+			return err
+		}
+		if err := updateVPCEPServicePermissions(client, d.Id(), "add", newSet.Difference(oldSet).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceVPCEPServiceV1Read(d, meta)
+}
+
+func resourceVPCEPServiceV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPCEP client: %s", err)
+	}
+
+	if err := DeleteService(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting VPC endpoint service")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    waitForVPCEPServiceStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for VPC endpoint service %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForVPCEPServiceStatus(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := GetService(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return service, "deleted", nil
+			}
+			return nil, "", err
+		}
+		if service.Status == "failed" {
+			return service, service.Status, fmt.Errorf("VPC endpoint service %s is in failed status", id)
+		}
+		return service, service.Status, nil
+	}
+}
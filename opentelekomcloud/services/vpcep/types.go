@@ -0,0 +1,248 @@
+package vpcep
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// PortMapping represents the port mapping of the VPC endpoint service.
+type PortMapping struct {
+	ClientPort int    `json:"client_port,omitempty"`
+	ServerPort int    `json:"server_port,omitempty"`
+	Protocol   string `json:"protocol,omitempty"`
+}
+
+// ServiceCreateOpts represents the attributes used when creating a new VPC endpoint service.
+type ServiceCreateOpts struct {
+	PortID          string             `json:"port_id" required:"true"`
+	VpcID           string             `json:"vpc_id" required:"true"`
+	ServerType      string             `json:"server_type" required:"true"`
+	ServiceName     string             `json:"service_name,omitempty"`
+	ServiceType     string             `json:"service_type,omitempty"`
+	ApprovalEnabled *bool              `json:"approval_enabled,omitempty"`
+	Ports           []PortMapping      `json:"ports" required:"true"`
+	Tags            []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// ToServiceCreateMap builds a request body from ServiceCreateOpts.
+func (opts ServiceCreateOpts) ToServiceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ServiceUpdateOpts represents the attributes used when updating the VPC endpoint service.
+type ServiceUpdateOpts struct {
+	ServiceName     *string       `json:"service_name,omitempty"`
+	ApprovalEnabled *bool         `json:"approval_enabled,omitempty"`
+	PortID          string        `json:"port_id,omitempty"`
+	Ports           []PortMapping `json:"ports,omitempty"`
+}
+
+// ToServiceUpdateMap builds a request body from ServiceUpdateOpts.
+func (opts ServiceUpdateOpts) ToServiceUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Service represents the VPC endpoint service.
+type Service struct {
+	ID              string             `json:"id"`
+	PortID          string             `json:"port_id"`
+	VpcID           string             `json:"vpc_id"`
+	ServerType      string             `json:"server_type"`
+	ServiceName     string             `json:"service_name"`
+	ServiceType     string             `json:"service_type"`
+	ApprovalEnabled bool               `json:"approval_enabled"`
+	Status          string             `json:"status"`
+	Ports           []PortMapping      `json:"ports"`
+	Tags            []tags.ResourceTag `json:"tags"`
+	ProjectID       string             `json:"project_id"`
+	CreatedAt       string             `json:"created_at"`
+	UpdatedAt       string             `json:"updated_at"`
+}
+
+type serviceResult struct {
+	golangsdk.Result
+}
+
+func (r serviceResult) extract() (*Service, error) {
+	var s Service
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateService creates a new VPC endpoint service.
+func CreateService(client *golangsdk.ServiceClient, opts ServiceCreateOpts) (*Service, error) {
+	b, err := opts.ToServiceCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r serviceResult
+	_, r.Err = client.Post(client.ServiceURL("vpc-endpoint-services"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// GetService retrieves the VPC endpoint service details.
+func GetService(client *golangsdk.ServiceClient, id string) (*Service, error) {
+	var r serviceResult
+	_, r.Err = client.Get(client.ServiceURL("vpc-endpoint-services", id), &r.Body, nil)
+	return r.extract()
+}
+
+// UpdateService updates the VPC endpoint service.
+func UpdateService(client *golangsdk.ServiceClient, id string, opts ServiceUpdateOpts) (*Service, error) {
+	b, err := opts.ToServiceUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r serviceResult
+	_, r.Err = client.Put(client.ServiceURL("vpc-endpoint-services", id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// DeleteService deletes the VPC endpoint service.
+func DeleteService(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpc-endpoint-services", id), nil)
+	return
+}
+
+// Permission represents the whitelist record of the VPC endpoint service.
+type Permission struct {
+	ID         string `json:"id"`
+	Permission string `json:"permission"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// ListServicePermissions retrieves the whitelist of the VPC endpoint service.
+func ListServicePermissions(client *golangsdk.ServiceClient, id string) ([]Permission, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("vpc-endpoint-services", id, "permissions"), &r.Body, nil)
+
+	var s struct {
+		Permissions []Permission `json:"permissions"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Permissions, err
+}
+
+// PermissionActionOpts represents the attributes used when adding or removing whitelist records.
+type PermissionActionOpts struct {
+	Permissions []string `json:"permissions" required:"true"`
+	Action      string   `json:"action" required:"true"`
+}
+
+// ToPermissionActionMap builds a request body from PermissionActionOpts.
+func (opts PermissionActionOpts) ToPermissionActionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateServicePermissions adds or removes the whitelist records of the VPC endpoint service.
+func UpdateServicePermissions(client *golangsdk.ServiceClient, id string, opts PermissionActionOpts) (r golangsdk.ErrResult) {
+	b, err := opts.ToPermissionActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(client.ServiceURL("vpc-endpoint-services", id, "permissions", "action"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Connection represents the connection of the VPC endpoint to the VPC endpoint service.
+type Connection struct {
+	ID        string `json:"id"`
+	MarkerID  int    `json:"marker_id"`
+	DomainID  string `json:"domain_id"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ListServiceConnections retrieves the connections of the VPC endpoint service.
+func ListServiceConnections(client *golangsdk.ServiceClient, id string) ([]Connection, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("vpc-endpoint-services", id, "connections"), &r.Body, nil)
+
+	var s struct {
+		Connections []Connection `json:"connections"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Connections, err
+}
+
+// EndpointCreateOpts represents the attributes used when creating a new VPC endpoint.
+type EndpointCreateOpts struct {
+	ServiceID string             `json:"endpoint_service_id" required:"true"`
+	VpcID     string             `json:"vpc_id" required:"true"`
+	SubnetID  string             `json:"subnet_id,omitempty"`
+	PortIP    string             `json:"port_ip,omitempty"`
+	EnableDNS *bool              `json:"enable_dns,omitempty"`
+	Tags      []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// ToEndpointCreateMap builds a request body from EndpointCreateOpts.
+func (opts EndpointCreateOpts) ToEndpointCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Endpoint represents the VPC endpoint.
+type Endpoint struct {
+	ID          string             `json:"id"`
+	ServiceID   string             `json:"endpoint_service_id"`
+	ServiceName string             `json:"endpoint_service_name"`
+	ServiceType string             `json:"service_type"`
+	VpcID       string             `json:"vpc_id"`
+	SubnetID    string             `json:"subnet_id"`
+	IP          string             `json:"ip"`
+	MarkerID    int                `json:"marker_id"`
+	EnableDNS   bool               `json:"enable_dns"`
+	DNSNames    []string           `json:"dns_names"`
+	Status      string             `json:"status"`
+	Tags        []tags.ResourceTag `json:"tags"`
+	ProjectID   string             `json:"project_id"`
+	CreatedAt   string             `json:"created_at"`
+	UpdatedAt   string             `json:"updated_at"`
+}
+
+type endpointResult struct {
+	golangsdk.Result
+}
+
+func (r endpointResult) extract() (*Endpoint, error) {
+	var s Endpoint
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateEndpoint creates a new VPC endpoint.
+func CreateEndpoint(client *golangsdk.ServiceClient, opts EndpointCreateOpts) (*Endpoint, error) {
+	b, err := opts.ToEndpointCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var r endpointResult
+	_, r.Err = client.Post(client.ServiceURL("vpc-endpoints"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return r.extract()
+}
+
+// GetEndpoint retrieves the VPC endpoint details.
+func GetEndpoint(client *golangsdk.ServiceClient, id string) (*Endpoint, error) {
+	var r endpointResult
+	_, r.Err = client.Get(client.ServiceURL("vpc-endpoints", id), &r.Body, nil)
+	return r.extract()
+}
+
+// DeleteEndpoint deletes the VPC endpoint.
+func DeleteEndpoint(client *golangsdk.ServiceClient, id string) (r golangsdk.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpc-endpoints", id), nil)
+	return
+}
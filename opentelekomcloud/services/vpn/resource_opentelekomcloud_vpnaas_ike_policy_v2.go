@@ -23,6 +23,8 @@ func ResourceVpnIKEPolicyV2() *schema.Resource {
 		Update: resourceVpnIKEPolicyV2Update,
 		Delete: resourceVpnIKEPolicyV2Delete,
 
+		CustomizeDiff: validateVpnIKEPolicyV2,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -20,6 +20,8 @@ func ResourceVpnIPSecPolicyV2() *schema.Resource {
 		Read:   resourceVpnIPSecPolicyV2Read,
 		Update: resourceVpnIPSecPolicyV2Update,
 		Delete: resourceVpnIPSecPolicyV2Delete,
+
+		CustomizeDiff: validateVpnIPSecPolicyV2,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -21,6 +21,9 @@ func ResourceVpnSiteConnectionV2() *schema.Resource {
 		Read:   resourceVpnSiteConnectionV2Read,
 		Update: resourceVpnSiteConnectionV2Update,
 		Delete: resourceVpnSiteConnectionV2Delete,
+
+		CustomizeDiff: validateVpnSiteConnectionV2Policies,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -141,6 +144,39 @@ func ResourceVpnSiteConnectionV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"tags": common.TagsSchema(),
+			"wait_for_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitoring": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -214,6 +250,20 @@ func resourceVpnSiteConnectionV2Create(d *schema.ResourceData, meta interface{})
 
 	d.SetId(conn.ID)
 
+	if d.Get("wait_for_active").(bool) {
+		activeStateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING_CREATE", "BUILD", "DOWN"},
+			Target:     []string{"ACTIVE"},
+			Refresh:    waitForSiteConnectionActive(networkingClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := activeStateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for VPN site connection %s to become ACTIVE: %s", d.Id(), err)
+		}
+	}
+
 	// create tags
 	tagRaw := d.Get("tags").(map[string]interface{})
 	if len(tagRaw) > 0 {
@@ -259,6 +309,14 @@ func resourceVpnSiteConnectionV2Read(d *schema.ResourceData, meta interface{}) e
 	// d.Set("psk", conn.PSK)
 	d.Set("mtu", conn.MTU)
 	d.Set("peer_cidrs", conn.PeerCIDRs)
+	d.Set("status", conn.Status)
+
+	// monitoring data is optional, the connection is still read when Cloud Eye can't be queried
+	monitoring, err := getSiteConnectionV2Monitoring(config, config.GetRegion(d), d.Id())
+	if err != nil {
+		log.Printf("[WARN] Unable to get monitoring data of site connection %s: %s", d.Id(), err)
+	}
+	d.Set("monitoring", monitoring)
 
 	// Set the dpd
 	var dpdMap map[string]interface{}
@@ -463,6 +521,35 @@ func waitForSiteConnectionCreation(networkingClient *golangsdk.ServiceClient, id
 	}
 }
 
+// siteConnectionDownGracePeriod is the time the connection can stay DOWN while the tunnel is negotiated
+const siteConnectionDownGracePeriod = 3 * time.Minute
+
+func waitForSiteConnectionActive(networkingClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	var downSince time.Time
+	return func() (interface{}, string, error) {
+		conn, err := siteconnections.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] SiteConnection %s status: %s", id, conn.Status)
+		switch conn.Status {
+		case "ERROR":
+			return conn, conn.Status, fmt.Errorf("site connection %s is in ERROR status, check psk and peer configuration", id)
+		case "DOWN":
+			if downSince.IsZero() {
+				downSince = time.Now()
+			}
+			if time.Since(downSince) > siteConnectionDownGracePeriod {
+				return conn, conn.Status, fmt.Errorf("site connection %s is DOWN for more than %s, check psk and peer configuration",
+					id, siteConnectionDownGracePeriod)
+			}
+		default:
+			downSince = time.Time{}
+		}
+		return conn, conn.Status, nil
+	}
+}
+
 func waitForSiteConnectionUpdate(networkingClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		conn, err := siteconnections.Get(networkingClient, id).Extract()
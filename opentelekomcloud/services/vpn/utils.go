@@ -0,0 +1,208 @@
+package vpn
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metricdata"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metrics"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/vpnaas/ipsecpolicies"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ces"
+)
+
+// vpnMetricsNamespace is the Cloud Eye namespace of the VPN metrics
+const vpnMetricsNamespace = "SYS.VPN"
+
+// validateIKEPolicyAlgorithms checks IKE policy parameter combinations rejected by OTC VPN
+func validateIKEPolicyAlgorithms(authAlgorithm, ikeVersion, lifetimeUnits string) error {
+	mErr := &multierror.Error{}
+	if ikeVersion == "v2" && authAlgorithm == "md5" {
+		mErr = multierror.Append(mErr, fmt.Errorf("auth_algorithm `md5` can only be used with ike_version `v1`"))
+	}
+	if lifetimeUnits != "" && lifetimeUnits != "seconds" {
+		mErr = multierror.Append(mErr, fmt.Errorf("lifetime units `%s` are not supported, only `seconds` can be used", lifetimeUnits))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// validateIPSecPolicyAlgorithms checks IPSec policy parameter combinations rejected by OTC VPN
+func validateIPSecPolicyAlgorithms(encapsulationMode, lifetimeUnits string) error {
+	mErr := &multierror.Error{}
+	if encapsulationMode != "" && encapsulationMode != "tunnel" {
+		mErr = multierror.Append(mErr, fmt.Errorf("encapsulation_mode `%s` is not supported, only `tunnel` can be used", encapsulationMode))
+	}
+	if lifetimeUnits != "" && lifetimeUnits != "seconds" {
+		mErr = multierror.Append(mErr, fmt.Errorf("lifetime units `%s` are not supported, only `seconds` can be used", lifetimeUnits))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// pfsGroupStrength orders the Diffie-Hellman groups by the equivalent modulus length
+var pfsGroupStrength = map[string]int{
+	"group1":  768,
+	"group2":  1024,
+	"group5":  1536,
+	"group14": 2048,
+	"group15": 3072,
+	"group19": 3072,
+	"group16": 4096,
+	"group20": 7680,
+	"group21": 15360,
+}
+
+// validatePolicyCombination checks the IKE and the IPSec policy combinations rejected by OTC VPN:
+// the IPSec SA has to expire before the IKE SA it is negotiated by and
+// the IPSec PFS group can't be weaker than the IKE one
+func validatePolicyCombination(ikePFS string, ikeLifetime int, ipsecPFS string, ipsecLifetime int) error {
+	mErr := &multierror.Error{}
+	if ikeLifetime > 0 && ipsecLifetime >= ikeLifetime {
+		mErr = multierror.Append(mErr, fmt.Errorf("IPSec policy lifetime (%d) has to be less than IKE policy lifetime (%d)", ipsecLifetime, ikeLifetime))
+	}
+	ikeStrength, ikeOK := pfsGroupStrength[ikePFS]
+	ipsecStrength, ipsecOK := pfsGroupStrength[ipsecPFS]
+	if ikeOK && ipsecOK && ipsecStrength < ikeStrength {
+		mErr = multierror.Append(mErr, fmt.Errorf("IPSec policy pfs `%s` is weaker than IKE policy pfs `%s`", ipsecPFS, ikePFS))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// lifetimeUnits returns units of the `lifetime` block set in the configuration
+func lifetimeUnits(d *schema.ResourceDiff) string {
+	for _, raw := range d.Get("lifetime").(*schema.Set).List() {
+		if units, ok := raw.(map[string]interface{})["units"].(string); ok && units != "" {
+			return units
+		}
+	}
+	return ""
+}
+
+func validateVpnIKEPolicyV2(d *schema.ResourceDiff, _ interface{}) error {
+	return validateIKEPolicyAlgorithms(
+		d.Get("auth_algorithm").(string),
+		d.Get("ike_version").(string),
+		lifetimeUnits(d),
+	)
+}
+
+func validateVpnIPSecPolicyV2(d *schema.ResourceDiff, _ interface{}) error {
+	return validateIPSecPolicyAlgorithms(d.Get("encapsulation_mode").(string), lifetimeUnits(d))
+}
+
+// validateVpnSiteConnectionV2Policies checks the existing IKE and IPSec policies used by the connection,
+// policies which are not created yet are validated by their own resources.
+// When both policies exist, they are checked against each other as well.
+func validateVpnSiteConnectionV2Policies(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("ikepolicy_id") && !d.HasChange("ipsecpolicy_id") {
+		return nil
+	}
+	ikePolicyID := d.Get("ikepolicy_id").(string)
+	ipsecPolicyID := d.Get("ipsecpolicy_id").(string)
+	checkIKE := d.NewValueKnown("ikepolicy_id") && ikePolicyID != ""
+	checkIPSec := d.NewValueKnown("ipsecpolicy_id") && ipsecPolicyID != ""
+	if !checkIKE && !checkIPSec {
+		return nil
+	}
+
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	mErr := &multierror.Error{}
+	var ikePolicy *ikepolicies.Policy
+	if checkIKE {
+		ikePolicy, err = ikepolicies.Get(client, ikePolicyID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving IKE policy %s: %s", ikePolicyID, err)
+		}
+		if err := validateIKEPolicyAlgorithms(ikePolicy.AuthAlgorithm, ikePolicy.IKEVersion, ikePolicy.Lifetime.Units); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("IKE policy %s can't be used: %s", ikePolicyID, err))
+		}
+	}
+	var ipsecPolicy *ipsecpolicies.Policy
+	if checkIPSec {
+		ipsecPolicy, err = ipsecpolicies.Get(client, ipsecPolicyID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving IPSec policy %s: %s", ipsecPolicyID, err)
+		}
+		if err := validateIPSecPolicyAlgorithms(ipsecPolicy.EncapsulationMode, ipsecPolicy.Lifetime.Units); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("IPSec policy %s can't be used: %s", ipsecPolicyID, err))
+		}
+	}
+	if ikePolicy != nil && ipsecPolicy != nil {
+		if err := validatePolicyCombination(ikePolicy.PFS, ikePolicy.Lifetime.Value, ipsecPolicy.PFS, ipsecPolicy.Lifetime.Value); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("IKE policy %s and IPSec policy %s can't be used together: %s", ikePolicyID, ipsecPolicyID, err))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// getSiteConnectionV2Monitoring returns the latest Cloud Eye data point of every VPN metric reported for the connection
+func getSiteConnectionV2Monitoring(config *cfg.Config, region, connectionID string) ([]map[string]interface{}, error) {
+	client, err := config.CesV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Cloud Eye Service client: %s", err)
+	}
+
+	limit := 1000
+	pages, err := metrics.List(client, metrics.ListOpts{Namespace: vpnMetricsNamespace, Limit: &limit}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing VPN metrics: %s", err)
+	}
+	metricList, err := metrics.ExtractAllPagesMetrics(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting VPN metrics: %s", err)
+	}
+
+	now := time.Now()
+	from := strconv.FormatInt(now.Add(-15*time.Minute).UnixNano()/int64(time.Millisecond), 10)
+	to := strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10)
+
+	var result []map[string]interface{}
+	for _, metric := range metricList.Metrics {
+		var dimension string
+		for _, dim := range metric.Dimensions {
+			if dim.Value == connectionID {
+				dimension = fmt.Sprintf("%s,%s", dim.Name, dim.Value)
+			}
+		}
+		if dimension == "" {
+			continue
+		}
+
+		data, err := ces.GetMetricData(client, metricdata.GetOpts{
+			Namespace:  vpnMetricsNamespace,
+			MetricName: metric.MetricName,
+			Dim0:       dimension,
+			Filter:     "average",
+			Period:     "1",
+			From:       from,
+			To:         to,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting data of VPN metric %s: %s", metric.MetricName, err)
+		}
+		if len(data.Datapoints) == 0 {
+			continue
+		}
+		latest := data.Datapoints[len(data.Datapoints)-1]
+		result = append(result, map[string]interface{}{
+			"metric_name": metric.MetricName,
+			"value":       latest.Average,
+			"unit":        latest.Unit,
+			"timestamp":   latest.Timestamp,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["metric_name"].(string) < result[j]["metric_name"].(string)
+	})
+	return result, nil
+}